@@ -2,43 +2,273 @@ package broker
 
 import (
 	"context"
+	"reflect"
+	"sync"
+	"time"
 
+	"github.com/jamesTait-jt/goflow/pkg/timerheap"
 	"github.com/jamesTait-jt/goflow/task"
 )
 
 // ChannelBroker is a task broker implementation that wraps a buffered Go channel.
 // It manages task submission and retrieval for the GoFlow framework.
+//
+// When brokering tasks, each priority level has its own buffered channel and a
+// dispatcher goroutine, started by the first call to Dequeue, forwards tasks from
+// the highest priority non-empty level to the queue read by workers.
 type ChannelBroker[T task.TaskOrResult] struct {
-	taskQueue chan T
+	taskQueue     chan T
+	levels        []chan T
+	scheduled     *timerheap.TimerHeap[T]
+	ctx           context.Context
+	cancel        context.CancelFunc
+	started       sync.Once
+	dispatchStart sync.Once
+	wg            *sync.WaitGroup
+
+	// Guards wg.Add against a concurrent AwaitShutdown
+	mu           sync.Mutex
+	shuttingDown bool
+
+	cancelledMu sync.RWMutex
+	cancelled   map[string]time.Time
+	cancelTTL   time.Duration
+	lastSweep   time.Time
+	now         func() time.Time
 }
 
 // NewChannelBroker creates a new ChannelBroker with a buffered channel of the
 // specified size. The buffer size determines how many tasks can be queued before
-// further submissions block.
+// further submissions block. For tasks, the buffer size applies to each priority
+// level.
 func NewChannelBroker[T task.TaskOrResult](bufferSize int) *ChannelBroker[T] {
-	q := make(chan T, bufferSize)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cb := &ChannelBroker[T]{
+		scheduled: timerheap.New[T](),
+		ctx:       ctx,
+		cancel:    cancel,
+		wg:        &sync.WaitGroup{},
+		cancelled: make(map[string]time.Time),
+		cancelTTL: defaultCancelTTL,
+		now:       time.Now,
+	}
+
+	numLevels := priorityLevels[T]()
+	if numLevels == 1 {
+		cb.taskQueue = make(chan T, bufferSize)
 
-	return &ChannelBroker[T]{taskQueue: q}
+		return cb
+	}
+
+	// Tasks wait in their priority level rather than in the queue, so that a higher
+	// priority task can overtake them
+	cb.taskQueue = make(chan T)
+	cb.levels = make([]chan T, numLevels)
+
+	for i := range cb.levels {
+		cb.levels[i] = make(chan T, bufferSize)
+	}
+
+	return cb
 }
 
 // Submit adds a task to the ChannelBroker's queue. If the queue is full, it will
 // block until space is available.
 func (cb *ChannelBroker[T]) Submit(ctx context.Context, t T) error {
+	queue := cb.taskQueue
+	if cb.levels != nil {
+		queue = cb.levels[priorityOf(t)]
+	}
+
 	select {
 	// Without this, it is possible for this goroutine to be locked trying to
 	// write to finished workers
 	case <-ctx.Done():
 		return nil
 
-	case cb.taskQueue <- t:
+	case queue <- t:
 		return nil
 	}
 }
 
+// SubmitBatch adds each submission to the queue in turn, blocking as Submit does.
+func (cb *ChannelBroker[T]) SubmitBatch(ctx context.Context, batch []T) error {
+	for _, t := range batch {
+		if err := cb.Submit(ctx, t); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SubmitAt holds the task in an in-memory timer heap and places it on the queue
+// once it is due. The first call starts a background goroutine that delivers due
+// tasks until the broker is closed, whatever the context of the call that started
+// it. Scheduled tasks are lost if the broker is closed or the process exits before
+// they are due.
+func (cb *ChannelBroker[T]) SubmitAt(_ context.Context, t T, at time.Time) error {
+	cb.started.Do(func() {
+		cb.goBackground(func() {
+			cb.scheduled.Run(cb.ctx, func(due T) {
+				_ = cb.Submit(cb.ctx, due)
+			})
+		})
+	})
+
+	cb.scheduled.Schedule(t, at)
+
+	return nil
+}
+
+// Cancel records that the task with the given ID has been cancelled. The task is
+// left on the queue, for workers to skip when they dequeue it. As with RedisBroker,
+// cancellations expire after 24 hours, and expired ones are swept from memory by
+// later calls to Cancel.
+func (cb *ChannelBroker[T]) Cancel(_ context.Context, taskID string) error {
+	cb.cancelledMu.Lock()
+	defer cb.cancelledMu.Unlock()
+
+	now := cb.now()
+
+	// Sweeping at most once per TTL keeps each call cheap, while holding at most
+	// two TTLs' worth of cancellations
+	if now.Sub(cb.lastSweep) >= cb.cancelTTL {
+		for id, cancelledAt := range cb.cancelled {
+			if cb.cancelExpired(cancelledAt, now) {
+				delete(cb.cancelled, id)
+			}
+		}
+
+		cb.lastSweep = now
+	}
+
+	cb.cancelled[taskID] = now
+
+	return nil
+}
+
+// Cancelled reports whether Cancel has been called for the task with the given ID,
+// within the last 24 hours.
+func (cb *ChannelBroker[T]) Cancelled(_ context.Context, taskID string) (bool, error) {
+	cb.cancelledMu.RLock()
+	defer cb.cancelledMu.RUnlock()
+
+	cancelledAt, ok := cb.cancelled[taskID]
+
+	return ok && !cb.cancelExpired(cancelledAt, cb.now()), nil
+}
+
+func (cb *ChannelBroker[T]) cancelExpired(cancelledAt, now time.Time) bool {
+	return !now.Before(cancelledAt.Add(cb.cancelTTL))
+}
+
 // Dequeue returns a read-only channel of tasks, allowing workers to retrieve
-// tasks for processing.
-func (cb *ChannelBroker[T]) Dequeue(_ context.Context) <-chan T {
+// tasks for processing. When brokering tasks, the first call starts the dispatcher,
+// which runs until the provided context is cancelled or the broker is closed.
+func (cb *ChannelBroker[T]) Dequeue(ctx context.Context) <-chan T {
+	if cb.levels != nil {
+		cb.dispatchStart.Do(func() {
+			cb.goBackground(func() {
+				// The dispatcher also stops when the broker is closed
+				ctx, stop := context.WithCancel(ctx)
+				defer stop()
+
+				defer context.AfterFunc(cb.ctx, stop)()
+
+				cb.dispatch(ctx)
+			})
+		})
+	}
+
 	return cb.taskQueue
 }
 
-func (cb *ChannelBroker[T]) AwaitShutdown() {}
+// dispatch moves tasks from the priority levels to the task queue, one at a time,
+// so that the task handed to the next free worker is always the highest priority
+// one waiting (subject to the starvation guard).
+func (cb *ChannelBroker[T]) dispatch(ctx context.Context) {
+	// Used to block until any level has a task when all are empty
+	cases := make([]reflect.SelectCase, 0, len(cb.levels)+1)
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())})
+
+	for _, level := range cb.levels {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(level)})
+	}
+
+	for n := uint64(0); ; n++ {
+		next, ok := cb.nextByPriority(n)
+
+		if !ok {
+			chosen, received, _ := reflect.Select(cases)
+			if chosen == 0 {
+				return
+			}
+
+			next, _ = received.Interface().(T)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case cb.taskQueue <- next:
+		}
+	}
+}
+
+// nextByPriority takes a task from the first non-empty priority level, in the
+// order given by the starvation guard, without blocking.
+func (cb *ChannelBroker[T]) nextByPriority(n uint64) (T, bool) {
+	for _, level := range dequeueOrder(len(cb.levels), n, defaultStarvationGuard) {
+		select {
+		case t := <-cb.levels[level]:
+			return t, true
+		default:
+		}
+	}
+
+	var zero T
+
+	return zero, false
+}
+
+// Close stops delivering scheduled and prioritised tasks and waits for the broker's
+// goroutines to finish.
+func (cb *ChannelBroker[T]) Close() error {
+	cb.cancel()
+	cb.AwaitShutdown()
+
+	return nil
+}
+
+// AwaitShutdown waits for the goroutines delivering scheduled and prioritised tasks
+// to finish, if they were started. Scheduled tasks are delivered until the broker is
+// closed, so call Close instead once SubmitAt has been used.
+func (cb *ChannelBroker[T]) AwaitShutdown() {
+	cb.mu.Lock()
+	cb.shuttingDown = true
+	cb.mu.Unlock()
+
+	cb.wg.Wait()
+}
+
+// goBackground runs f in a goroutine tracked by AwaitShutdown. Once AwaitShutdown
+// has been called, f is not run, as it would only be waited on by a later call.
+func (cb *ChannelBroker[T]) goBackground(f func()) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.shuttingDown {
+		return
+	}
+
+	cb.wg.Add(1)
+
+	go func() {
+		defer cb.wg.Done()
+
+		f()
+	}()
+}
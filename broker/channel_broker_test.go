@@ -17,10 +17,27 @@ func Test_NewChannelBroker(t *testing.T) {
 		bufferSize := 10
 
 		// Act
-		b := NewChannelBroker[task.Task](bufferSize)
+		b := NewChannelBroker[task.Result](bufferSize)
 
 		// Assert
 		assert.Equal(t, bufferSize, cap(b.taskQueue))
+		assert.Nil(t, b.levels)
+	})
+
+	t.Run("Creates a buffered channel for each priority level when brokering tasks", func(t *testing.T) {
+		// Arrange
+		bufferSize := 10
+
+		// Act
+		b := NewChannelBroker[task.Task](bufferSize)
+
+		// Assert
+		assert.Equal(t, 0, cap(b.taskQueue))
+		assert.Len(t, b.levels, task.MaxPriority+1)
+
+		for _, level := range b.levels {
+			assert.Equal(t, bufferSize, cap(level))
+		}
 	})
 }
 
@@ -117,6 +134,213 @@ func Test_ChannelBroker_Submit(t *testing.T) {
 	})
 }
 
+func Test_ChannelBroker_SubmitBatch(t *testing.T) {
+	t.Run("Places each task on the queue in order", func(t *testing.T) {
+		// Arrange
+		b := ChannelBroker[task.Result]{
+			taskQueue: make(chan task.Result, 2),
+		}
+
+		// Act
+		err := b.SubmitBatch(context.Background(), []task.Result{{TaskID: "first"}, {TaskID: "second"}})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "first", (<-b.taskQueue).TaskID)
+		assert.Equal(t, "second", (<-b.taskQueue).TaskID)
+	})
+}
+
+func Test_ChannelBroker_Cancel(t *testing.T) {
+	t.Run("Reports only the cancelled tasks as cancelled", func(t *testing.T) {
+		// Arrange
+		b := NewChannelBroker[task.Task](1)
+
+		// Act
+		err := b.Cancel(context.Background(), "cancelled")
+
+		cancelled, cancelledErr := b.Cancelled(context.Background(), "cancelled")
+		other, otherErr := b.Cancelled(context.Background(), "other")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, cancelledErr)
+		assert.NoError(t, otherErr)
+		assert.True(t, cancelled)
+		assert.False(t, other)
+	})
+
+	t.Run("Expires cancellations and sweeps them from memory", func(t *testing.T) {
+		// Arrange
+		b := NewChannelBroker[task.Task](1)
+
+		now := time.Now()
+		b.now = func() time.Time { return now }
+
+		_ = b.Cancel(context.Background(), "old")
+
+		now = now.Add(defaultCancelTTL)
+
+		// Act
+		expired, _ := b.Cancelled(context.Background(), "old")
+
+		err := b.Cancel(context.Background(), "new")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, expired)
+		assert.NotContains(t, b.cancelled, "old")
+		assert.Contains(t, b.cancelled, "new")
+	})
+}
+
+func Test_ChannelBroker_SubmitAt(t *testing.T) {
+	t.Run("Places the task on the task queue once it is due", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		b := NewChannelBroker[task.Task](1)
+
+		tsk := task.Task{ID: "randomID"}
+		at := time.Now().Add(20 * time.Millisecond)
+
+		// Act
+		err := b.SubmitAt(ctx, tsk, at)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, b.taskQueue)
+
+		select {
+		case received := <-b.Dequeue(ctx):
+			assert.Equal(t, tsk, received)
+			assert.False(t, time.Now().Before(at))
+		case <-time.After(time.Second):
+			t.Fatal("scheduled task was not delivered")
+		}
+
+		cancel()
+		assert.NoError(t, b.Close())
+	})
+
+	t.Run("Keeps delivering tasks once the context of the first call is cancelled", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		b := NewChannelBroker[task.Task](1)
+
+		tsk := task.Task{ID: "randomID"}
+
+		// Act
+		firstErr := b.SubmitAt(ctx, task.Task{ID: "first"}, time.Now().Add(time.Hour))
+		cancel()
+
+		err := b.SubmitAt(context.Background(), tsk, time.Now().Add(20*time.Millisecond))
+
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.NoError(t, err)
+
+		select {
+		case received := <-b.Dequeue(context.Background()):
+			assert.Equal(t, tsk, received)
+		case <-time.After(time.Second):
+			t.Fatal("scheduled task was not delivered")
+		}
+
+		assert.NoError(t, b.Close())
+	})
+
+	t.Run("Does not deliver tasks that are not due when the broker is closed", func(t *testing.T) {
+		// Arrange
+		b := NewChannelBroker[task.Task](1)
+
+		// Act
+		err := b.SubmitAt(context.Background(), task.Task{ID: "randomID"}, time.Now().Add(time.Hour))
+		closeErr := b.Close()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, closeErr)
+		assert.Empty(t, b.taskQueue)
+	})
+}
+
+func Test_ChannelBroker_Priority(t *testing.T) {
+	t.Run("Dequeues higher priority tasks first", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		b := NewChannelBroker[task.Task](2)
+
+		_ = b.Submit(ctx, task.Task{ID: "low-1"})
+		_ = b.Submit(ctx, task.Task{ID: "mid", Priority: 5})
+		_ = b.Submit(ctx, task.Task{ID: "low-2"})
+		_ = b.Submit(ctx, task.Task{ID: "high", Priority: task.MaxPriority})
+
+		// Act
+		var received []string
+
+		for i := 0; i < 4; i++ {
+			received = append(received, (<-b.Dequeue(ctx)).ID)
+		}
+
+		cancel()
+		b.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, []string{"high", "mid", "low-1", "low-2"}, received)
+	})
+
+	t.Run("Dequeues the lowest priority task on every starvation guard-th dequeue", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		b := NewChannelBroker[task.Task](defaultStarvationGuard)
+
+		_ = b.Submit(ctx, task.Task{ID: "low"})
+
+		for i := 0; i < defaultStarvationGuard; i++ {
+			_ = b.Submit(ctx, task.Task{ID: "high", Priority: task.MaxPriority})
+		}
+
+		// Act
+		var received []string
+
+		for i := 0; i < defaultStarvationGuard; i++ {
+			received = append(received, (<-b.Dequeue(ctx)).ID)
+		}
+
+		cancel()
+		b.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "low", received[defaultStarvationGuard-1])
+		assert.NotContains(t, received[:defaultStarvationGuard-1], "low")
+	})
+
+	t.Run("Delivers tasks submitted after the dispatcher is waiting", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		b := NewChannelBroker[task.Task](0)
+		queue := b.Dequeue(ctx)
+
+		// Act
+		go func() {
+			_ = b.Submit(ctx, task.Task{ID: "late", Priority: 3})
+		}()
+
+		received := <-queue
+
+		cancel()
+		b.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "late", received.ID)
+	})
+}
+
 func Test_ChannelBroker_Dequeue(t *testing.T) {
 	t.Run("Returns the task queue", func(t *testing.T) {
 		// Arrange
@@ -1,16 +1,32 @@
 package broker
 
 import (
+	"time"
+
 	"github.com/jamesTait-jt/goflow/pkg/log"
 )
 
+var (
+	defaultSchedulePollInterval = time.Second
+	defaultPromoteBatchSize     = 100
+	defaultCancelTTL            = 24 * time.Hour
+)
+
 type redisBrokerOptions struct {
-	logger log.Logger
+	logger               log.Logger
+	schedulePollInterval time.Duration
+	promoteBatchSize     int
+	starvationGuard      int
+	cancelTTL            time.Duration
 }
 
 func defaultRedisBrokerOptions() redisBrokerOptions {
 	return redisBrokerOptions{
-		logger: log.NewConsoleLogger(),
+		logger:               log.NewConsoleLogger(),
+		schedulePollInterval: defaultSchedulePollInterval,
+		promoteBatchSize:     defaultPromoteBatchSize,
+		starvationGuard:      defaultStarvationGuard,
+		cancelTTL:            defaultCancelTTL,
 	}
 }
 
@@ -32,3 +48,48 @@ func (l loggerOption) apply(opts *redisBrokerOptions) {
 func WithLogger(logger log.Logger) RedisBrokerOption {
 	return loggerOption{Logger: logger}
 }
+
+type schedulePollIntervalOption struct {
+	SchedulePollInterval time.Duration
+}
+
+func (s schedulePollIntervalOption) apply(opts *redisBrokerOptions) {
+	opts.schedulePollInterval = s.SchedulePollInterval
+}
+
+// WithSchedulePollInterval allows you to set how often consumers check for scheduled
+// submissions that have become due. Scheduled submissions are delivered up to one
+// interval late. Defaults to one second.
+func WithSchedulePollInterval(interval time.Duration) RedisBrokerOption {
+	return schedulePollIntervalOption{SchedulePollInterval: interval}
+}
+
+type starvationGuardOption struct {
+	StarvationGuard int
+}
+
+func (s starvationGuardOption) apply(opts *redisBrokerOptions) {
+	opts.starvationGuard = s.StarvationGuard
+}
+
+// WithStarvationGuard allows you to set how often, in dequeues, a consumer takes the
+// lowest priority task available instead of the highest, so that low priority tasks
+// are not starved by a steady stream of higher priority ones. Zero disables the
+// guard. Defaults to every 10th dequeue.
+func WithStarvationGuard(every int) RedisBrokerOption {
+	return starvationGuardOption{StarvationGuard: every}
+}
+
+type cancelTTLOption struct {
+	CancelTTL time.Duration
+}
+
+func (c cancelTTLOption) apply(opts *redisBrokerOptions) {
+	opts.cancelTTL = c.CancelTTL
+}
+
+// WithCancelTTL allows you to set how long cancellations are kept in Redis. A task
+// that is still queued when its cancellation expires will run. Defaults to 24 hours.
+func WithCancelTTL(ttl time.Duration) RedisBrokerOption {
+	return cancelTTLOption{CancelTTL: ttl}
+}
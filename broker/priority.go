@@ -0,0 +1,50 @@
+package broker
+
+import "github.com/jamesTait-jt/goflow/task"
+
+// defaultStarvationGuard is how often, in dequeues, the brokers check the priority
+// levels lowest first instead of highest first, so that low priority tasks still
+// make progress while higher priority work keeps arriving.
+const defaultStarvationGuard = 10
+
+// priorityLevels returns the number of priority levels that submissions of type T
+// are split across. Only tasks have a priority, so results always use one level.
+func priorityLevels[T task.TaskOrResult]() int {
+	var zero T
+
+	if _, ok := any(zero).(task.Task); ok {
+		return task.MaxPriority + 1
+	}
+
+	return 1
+}
+
+// priorityOf returns the priority level of the submission, clamped to the valid
+// range.
+func priorityOf[T task.TaskOrResult](submission T) int {
+	t, ok := any(submission).(task.Task)
+	if !ok {
+		return 0
+	}
+
+	return min(max(t.Priority, 0), task.MaxPriority)
+}
+
+// dequeueOrder returns the priority levels in the order they should be checked for
+// the nth dequeue: highest first, except on every guard-th dequeue where it is
+// lowest first. A guard of zero or less disables the starvation guard.
+func dequeueOrder(levels int, n uint64, guard int) []int {
+	order := make([]int, levels)
+
+	lowestFirst := guard > 0 && n%uint64(guard) == uint64(guard-1)
+
+	for i := range order {
+		if lowestFirst {
+			order[i] = i
+		} else {
+			order[i] = levels - 1 - i
+		}
+	}
+
+	return order
+}
@@ -3,6 +3,7 @@ package broker
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -13,8 +14,48 @@ import (
 type redisClient interface {
 	LPush(ctx context.Context, key string, values ...any) *redis.IntCmd
 	BRPop(ctx context.Context, timeout time.Duration, keys ...string) *redis.StringSliceCmd
+	ZAdd(ctx context.Context, key string, members ...redis.Z) *redis.IntCmd
+	Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd
+	Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd
+	Exists(ctx context.Context, keys ...string) *redis.IntCmd
 }
 
+// promoteScript atomically moves due members of each scheduled sorted set onto its
+// queue. KEYS holds pairs of scheduled set and queue, one pair per priority level.
+// Scores are due times in unix milliseconds, and at most ARGV[2] members are moved
+// from each set. Running it atomically means any number of consumers can promote
+// concurrently without delivering a task twice.
+const promoteScript = `
+local promoted = 0
+for i = 1, #KEYS, 2 do
+	local due = redis.call('ZRANGEBYSCORE', KEYS[i], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+	for _, member in ipairs(due) do
+		redis.call('ZREM', KEYS[i], member)
+		redis.call('LPUSH', KEYS[i + 1], member)
+	end
+	promoted = promoted + #due
+end
+return promoted
+`
+
+// submitBatchScript atomically pushes a batch spanning several priority levels, so
+// that either every level is pushed or none is. KEYS holds the queue of each level,
+// ARGV[i] the number of members pushed to KEYS[i], and the members follow, in order
+// of level. Members are pushed in chunks to stay within Lua's limit on unpacked
+// values.
+const submitBatchScript = `
+local arg = #KEYS + 1
+for i = 1, #KEYS do
+	local last = arg + tonumber(ARGV[i]) - 1
+	while arg <= last do
+		local chunkEnd = math.min(arg + 999, last)
+		redis.call('LPUSH', KEYS[i], unpack(ARGV, arg, chunkEnd))
+		arg = chunkEnd + 1
+	end
+end
+return #ARGV - #KEYS
+`
+
 // Encoder defines methods for serializing and deserializing tasks of type T, where
 // T satisfies task.TaskOrResult. This allows tasks and results to be encoded for
 // sending over Redis and decoded when retrieved.
@@ -26,6 +67,10 @@ type Encoder[T task.TaskOrResult] interface {
 // RedisBroker is a Redis-backed message broker that supports submitting and
 // asynchronously retrieving tasks of type T. It provides a channel-based interface for
 // consuming tasks and includes options for configuring logging.
+//
+// Tasks with the default priority are queued under the broker's key, and tasks with
+// priority p under key + ":p<p>", so that consumers can pop from the highest
+// priority non-empty queue.
 type RedisBroker[T task.TaskOrResult] struct {
 	client        redisClient
 	redisQueueKey string
@@ -34,6 +79,7 @@ type RedisBroker[T task.TaskOrResult] struct {
 	wg            *sync.WaitGroup
 	encoder       Encoder[T]
 	opts          redisBrokerOptions
+	numLevels     int
 }
 
 // NewRedisBroker creates a new RedisBroker instance with the specified Redis client, queue key,
@@ -57,18 +103,19 @@ func NewRedisBroker[T task.TaskOrResult](
 		opts:          opts,
 		outChan:       make(chan T),
 		wg:            &sync.WaitGroup{},
+		numLevels:     priorityLevels[T](),
 	}
 }
 
-// Submit serializes a task and pushes it to the Redis queue. If serialization or
-// pushing fails, Submit returns an error.
+// Submit serializes a task and pushes it to the Redis queue for its priority. If
+// serialization or pushing fails, Submit returns an error.
 func (rb *RedisBroker[T]) Submit(ctx context.Context, submission T) error {
 	serialised, err := rb.encoder.Serialise(submission)
 	if err != nil {
 		return err
 	}
 
-	_, err = rb.client.LPush(ctx, rb.redisQueueKey, serialised).Result()
+	_, err = rb.client.LPush(ctx, rb.queueKey(priorityOf(submission)), serialised).Result()
 	if err != nil {
 		return err
 	}
@@ -76,15 +123,95 @@ func (rb *RedisBroker[T]) Submit(ctx context.Context, submission T) error {
 	return nil
 }
 
+// SubmitBatch serializes every submission and places them on the queue in one round
+// trip, rather than one each. A batch at a single priority level is sent as one
+// multi-value LPUSH, and a batch spanning several levels as one script that pushes
+// to each, so the batch is submitted atomically. If serialising any submission
+// fails, nothing is submitted.
+func (rb *RedisBroker[T]) SubmitBatch(ctx context.Context, batch []T) error {
+	byKey := map[string][]any{}
+	keys := []string{}
+
+	for _, submission := range batch {
+		serialised, err := rb.encoder.Serialise(submission)
+		if err != nil {
+			return err
+		}
+
+		key := rb.queueKey(priorityOf(submission))
+		if _, ok := byKey[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		byKey[key] = append(byKey[key], serialised)
+	}
+
+	if len(keys) == 1 {
+		return rb.client.LPush(ctx, keys[0], byKey[keys[0]]...).Err()
+	}
+
+	args := make([]any, 0, len(keys)+len(batch))
+	for _, key := range keys {
+		args = append(args, len(byKey[key]))
+	}
+
+	for _, key := range keys {
+		args = append(args, byKey[key]...)
+	}
+
+	return rb.client.Eval(ctx, submitBatchScript, keys, args...).Err()
+}
+
+// SubmitAt serializes a task and adds it to a Redis sorted set scored by its due
+// time. Consumers calling Dequeue periodically promote due tasks from the sorted set
+// onto the queue, so scheduled tasks survive restarts of both producers and
+// consumers.
+func (rb *RedisBroker[T]) SubmitAt(ctx context.Context, submission T, at time.Time) error {
+	serialised, err := rb.encoder.Serialise(submission)
+	if err != nil {
+		return err
+	}
+
+	_, err = rb.client.ZAdd(ctx, rb.scheduledKey(priorityOf(submission)), redis.Z{
+		Score:  float64(at.UnixMilli()),
+		Member: serialised,
+	}).Result()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Cancel records the cancellation of the task with the given ID under key +
+// ":cancelled:" + taskID, so that every consumer of the queue sees it. The task is
+// left on the queue, for workers to skip when they dequeue it. Cancellations expire
+// after the cancellation TTL (see WithCancelTTL).
+func (rb *RedisBroker[T]) Cancel(ctx context.Context, taskID string) error {
+	return rb.client.Set(ctx, rb.cancelledKey(taskID), 1, rb.opts.cancelTTL).Err()
+}
+
+// Cancelled reports whether the task with the given ID has been cancelled.
+func (rb *RedisBroker[T]) Cancelled(ctx context.Context, taskID string) (bool, error) {
+	n, err := rb.client.Exists(ctx, rb.cancelledKey(taskID)).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
 // Dequeue returns a receive-only channel that emits tasks as they are retrieved from
 // the Redis queue. Dequeue starts a background goroutine for polling tasks from the
-// queue. The polling process stops when the provided context is canceled. errors are
+// queue, and another for promoting scheduled tasks onto the queue once they are due.
+// The polling process stops when the provided context is canceled. errors are
 // logged but they will not stop the polling loop. pollRedis exits when the provided
 // context is cancelled.
 func (rb *RedisBroker[T]) Dequeue(ctx context.Context) <-chan T {
 	rb.started.Do(func() {
-		rb.wg.Add(1)
+		rb.wg.Add(2) // nolint:mnd // pollRedis and promoteScheduled
 		go rb.pollRedis(ctx)
+		go rb.promoteScheduled(ctx)
 	})
 
 	return rb.outChan
@@ -93,8 +220,17 @@ func (rb *RedisBroker[T]) Dequeue(ctx context.Context) <-chan T {
 func (rb *RedisBroker[T]) pollRedis(ctx context.Context) {
 	defer rb.wg.Done()
 
-	for {
-		redisResult, err := rb.client.BRPop(ctx, 0, rb.redisQueueKey).Result()
+	for n := uint64(0); ; n++ {
+		// BRPOP pops from the first non-empty key, so the order of the keys is the
+		// order in which priorities are served
+		order := dequeueOrder(rb.numLevels, n, rb.opts.starvationGuard)
+		keys := make([]string, 0, len(order))
+
+		for _, level := range order {
+			keys = append(keys, rb.queueKey(level))
+		}
+
+		redisResult, err := rb.client.BRPop(ctx, 0, keys...).Result()
 		if err != nil {
 			if errors.Is(err, context.Canceled) {
 				return
@@ -116,7 +252,55 @@ func (rb *RedisBroker[T]) pollRedis(ctx context.Context) {
 	}
 }
 
-// AwaitShutdown waits for the background polling goroutine to finish.
+func (rb *RedisBroker[T]) promoteScheduled(ctx context.Context) {
+	defer rb.wg.Done()
+
+	keys := make([]string, 0, 2*rb.numLevels) // nolint:mnd // a scheduled set and a queue per level
+
+	for level := 0; level < rb.numLevels; level++ {
+		keys = append(keys, rb.scheduledKey(level), rb.queueKey(level))
+	}
+
+	ticker := time.NewTicker(rb.opts.schedulePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			_, err := rb.client.Eval(
+				ctx,
+				promoteScript,
+				keys,
+				time.Now().UnixMilli(),
+				rb.opts.promoteBatchSize,
+			).Result()
+			if err != nil && !errors.Is(err, context.Canceled) {
+				rb.opts.logger.Warn(err.Error())
+			}
+		}
+	}
+}
+
+func (rb *RedisBroker[T]) queueKey(priority int) string {
+	if priority == 0 {
+		return rb.redisQueueKey
+	}
+
+	return fmt.Sprintf("%s:p%d", rb.redisQueueKey, priority)
+}
+
+func (rb *RedisBroker[T]) scheduledKey(priority int) string {
+	return rb.queueKey(priority) + ":scheduled"
+}
+
+func (rb *RedisBroker[T]) cancelledKey(taskID string) string {
+	return rb.redisQueueKey + ":cancelled:" + taskID
+}
+
+// AwaitShutdown waits for the background polling goroutines to finish.
 // This method should be called during shutdown to ensure all resources are released.
 func (rb *RedisBroker[T]) AwaitShutdown() {
 	rb.wg.Wait()
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -74,6 +75,34 @@ func Test_RedisBroker_Submit(t *testing.T) {
 		mockClient.AssertExpectations(t)
 	})
 
+	t.Run("Places the task on the queue for its priority", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		tsk := task.Task{
+			ID:       "randomID",
+			Priority: 5,
+		}
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+
+		b := NewRedisBroker(mockClient, "queue", encoder)
+
+		serialised := []byte{1, 2, 3, 4}
+		encoder.On("Serialise", tsk).Return(serialised, nil)
+
+		returnedCmd := &redis.IntCmd{}
+		mockClient.On("LPush", ctx, "queue:p5", []interface{}{serialised}).Return(returnedCmd)
+
+		// Act
+		err := b.Submit(ctx, tsk)
+
+		// Assert
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+
 	t.Run("Does not push to redis if serialisation fails and returns error", func(t *testing.T) {
 		// Arrange
 		ctx := context.Background()
@@ -131,10 +160,366 @@ func Test_RedisBroker_Submit(t *testing.T) {
 	})
 }
 
+func Test_RedisBroker_SubmitBatch(t *testing.T) {
+	t.Run("Pushes a batch spanning several priority levels with one script", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		first := task.Task{ID: "first"}
+		urgent := task.Task{ID: "urgent", Priority: 9}
+		second := task.Task{ID: "second"}
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+
+		b := NewRedisBroker(mockClient, "queue", encoder)
+
+		encoder.On("Serialise", first).Return([]byte{1}, nil)
+		encoder.On("Serialise", urgent).Return([]byte{2}, nil)
+		encoder.On("Serialise", second).Return([]byte{3}, nil)
+
+		mockClient.On(
+			"Eval",
+			ctx,
+			submitBatchScript,
+			[]string{"queue", "queue:p9"},
+			[]any{2, 1, []byte{1}, []byte{3}, []byte{2}},
+		).Once().Return(&redis.Cmd{})
+
+		// Act
+		err := b.SubmitBatch(ctx, []task.Task{first, urgent, second})
+
+		// Assert
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+		mockClient.AssertNotCalled(t, "LPush")
+	})
+
+	t.Run("Pushes a batch at one priority level with one LPUSH", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+
+		b := NewRedisBroker(mockClient, "queue", encoder)
+
+		encoder.On("Serialise", task.Task{ID: "first"}).Return([]byte{1}, nil)
+		encoder.On("Serialise", task.Task{ID: "second"}).Return([]byte{2}, nil)
+
+		mockClient.On("LPush", ctx, "queue", []interface{}{[]byte{1}, []byte{2}}).Once().Return(&redis.IntCmd{})
+
+		// Act
+		err := b.SubmitBatch(ctx, []task.Task{{ID: "first"}, {ID: "second"}})
+
+		// Assert
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Returns error if the script pushing several priority levels fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+
+		b := NewRedisBroker(mockClient, "queue", encoder)
+
+		encoder.On("Serialise", task.Task{ID: "first"}).Return([]byte{1}, nil)
+		encoder.On("Serialise", task.Task{ID: "urgent", Priority: 9}).Return([]byte{2}, nil)
+
+		evalErr := errors.New("eval error")
+		returnedCmd := &redis.Cmd{}
+		returnedCmd.SetErr(evalErr)
+		mockClient.On("Eval", ctx, submitBatchScript, mock.Anything, mock.Anything).Return(returnedCmd)
+
+		// Act
+		err := b.SubmitBatch(ctx, []task.Task{{ID: "first"}, {ID: "urgent", Priority: 9}})
+
+		// Assert
+		assert.ErrorIs(t, err, evalErr)
+	})
+
+	t.Run("Does not push anything if serialising any task fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+
+		b := NewRedisBroker(mockClient, "queue", encoder)
+
+		serialiserError := errors.New("failed to serialise")
+		encoder.On("Serialise", task.Task{ID: "first"}).Return([]byte{1}, nil)
+		encoder.On("Serialise", task.Task{ID: "second"}).Return([]byte{}, serialiserError)
+
+		// Act
+		err := b.SubmitBatch(ctx, []task.Task{{ID: "first"}, {ID: "second"}})
+
+		// Assert
+		assert.ErrorIs(t, err, serialiserError)
+		mockClient.AssertNotCalled(t, "LPush")
+	})
+
+	t.Run("Returns error if push to redis fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+
+		b := NewRedisBroker(mockClient, "queue", encoder)
+
+		encoder.On("Serialise", task.Task{ID: "first"}).Return([]byte{1}, nil)
+
+		lpushErr := errors.New("lpush error")
+		returnedCmd := &redis.IntCmd{}
+		returnedCmd.SetErr(lpushErr)
+		mockClient.On("LPush", ctx, "queue", []interface{}{[]byte{1}}).Return(returnedCmd)
+
+		// Act
+		err := b.SubmitBatch(ctx, []task.Task{{ID: "first"}})
+
+		// Assert
+		assert.ErrorIs(t, err, lpushErr)
+	})
+}
+
+func Test_RedisBroker_SubmitAt(t *testing.T) {
+	t.Run("Serialises the task and adds it to the scheduled set scored by due time", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		tsk := task.Task{
+			ID: "randomID",
+		}
+
+		mockClient := new(mockRedisClient)
+		queueKey := "queue"
+		encoder := new(mockEncoder[task.Task])
+
+		b := NewRedisBroker(mockClient, queueKey, encoder)
+
+		serialised := []byte{1, 2, 3, 4}
+		encoder.On("Serialise", tsk).Return(serialised, nil)
+
+		at := time.UnixMilli(1700000000000)
+		expectedMembers := []redis.Z{{Score: float64(at.UnixMilli()), Member: serialised}}
+
+		returnedCmd := &redis.IntCmd{}
+		mockClient.On("ZAdd", ctx, "queue:scheduled", expectedMembers).Return(returnedCmd)
+
+		// Act
+		err := b.SubmitAt(ctx, tsk, at)
+
+		// Assert
+		assert.NoError(t, err)
+		encoder.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Does not add to redis if serialisation fails and returns error", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+
+		b := NewRedisBroker(mockClient, "queue", encoder)
+
+		serialiserError := errors.New("failed to serialise")
+		encoder.On("Serialise", task.Task{}).Return([]byte{}, serialiserError)
+
+		// Act
+		err := b.SubmitAt(ctx, task.Task{}, time.Now())
+
+		// Assert
+		assert.EqualError(t, err, serialiserError.Error())
+		mockClient.AssertNotCalled(t, "ZAdd")
+	})
+
+	t.Run("Returns error if adding to redis fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+
+		b := NewRedisBroker(mockClient, "queue", encoder)
+
+		encoder.On("Serialise", task.Task{}).Return([]byte{1}, nil)
+
+		zaddErr := errors.New("zadd error")
+		returnedCmd := &redis.IntCmd{}
+		returnedCmd.SetErr(zaddErr)
+		mockClient.On("ZAdd", ctx, "queue:scheduled", mock.Anything).Return(returnedCmd)
+
+		// Act
+		err := b.SubmitAt(ctx, task.Task{}, time.Now())
+
+		// Assert
+		assert.EqualError(t, err, zaddErr.Error())
+	})
+}
+
+func Test_RedisBroker_Cancel(t *testing.T) {
+	t.Run("Records the cancellation with the cancellation TTL", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+
+		b := NewRedisBroker(mockClient, "queue", encoder, WithCancelTTL(time.Hour))
+
+		mockClient.On("Set", ctx, "queue:cancelled:task-id", 1, time.Hour).Return(&redis.StatusCmd{})
+
+		// Act
+		err := b.Cancel(ctx, "task-id")
+
+		// Assert
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Returns an error if redis fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+
+		b := NewRedisBroker(mockClient, "queue", encoder)
+
+		returnedCmd := &redis.StatusCmd{}
+		returnedCmd.SetErr(redis.ErrClosed)
+		mockClient.On("Set", ctx, "queue:cancelled:task-id", 1, defaultCancelTTL).Return(returnedCmd)
+
+		// Act
+		err := b.Cancel(ctx, "task-id")
+
+		// Assert
+		assert.ErrorIs(t, err, redis.ErrClosed)
+	})
+}
+
+func Test_RedisBroker_Cancelled(t *testing.T) {
+	tests := []struct {
+		name     string
+		exists   int64
+		expected bool
+	}{
+		{"Reports a task with a cancellation as cancelled", 1, true},
+		{"Reports a task without a cancellation as not cancelled", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			mockClient := new(mockRedisClient)
+			encoder := new(mockEncoder[task.Task])
+
+			b := NewRedisBroker(mockClient, "queue", encoder)
+
+			returnedCmd := &redis.IntCmd{}
+			returnedCmd.SetVal(tt.exists)
+			mockClient.On("Exists", ctx, []string{"queue:cancelled:task-id"}).Return(returnedCmd)
+
+			// Act
+			cancelled, err := b.Cancelled(ctx, "task-id")
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cancelled)
+		})
+	}
+
+	t.Run("Returns an error if redis fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+
+		b := NewRedisBroker(mockClient, "queue", encoder)
+
+		returnedCmd := &redis.IntCmd{}
+		returnedCmd.SetErr(redis.ErrClosed)
+		mockClient.On("Exists", ctx, []string{"queue:cancelled:task-id"}).Return(returnedCmd)
+
+		// Act
+		_, err := b.Cancelled(ctx, "task-id")
+
+		// Assert
+		assert.ErrorIs(t, err, redis.ErrClosed)
+	})
+}
+
+func Test_RedisBroker_promoteScheduled(t *testing.T) {
+	t.Run("Periodically promotes due scheduled tasks onto the queue", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+		logger := new(log.TestifyMock)
+
+		br := NewRedisBroker(mockClient, "queue", encoder, WithLogger(logger), WithSchedulePollInterval(time.Millisecond))
+
+		returnedCmd := &redis.Cmd{}
+		returnedCmd.SetVal(int64(0))
+		mockClient.On("Eval", ctx, promoteScript, scheduledKeyPairs("queue"), mock.Anything).
+			Run(func(_ mock.Arguments) { cancel() }).
+			Return(returnedCmd)
+
+		// Act
+		br.wg.Add(1)
+		br.promoteScheduled(ctx)
+
+		// Assert
+		mockClient.AssertExpectations(t)
+		logger.AssertExpectations(t)
+	})
+
+	t.Run("Logs errors and continues promoting", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Task])
+		logger := new(log.TestifyMock)
+
+		br := NewRedisBroker(mockClient, "queue", encoder, WithLogger(logger), WithSchedulePollInterval(time.Millisecond))
+
+		failedCmd := &redis.Cmd{}
+		failedCmd.SetErr(redis.ErrClosed)
+		mockClient.On("Eval", ctx, promoteScript, mock.Anything, mock.Anything).Once().Return(failedCmd)
+
+		logger.On("Warn", "redis: client is closed").Once()
+
+		succeededCmd := &redis.Cmd{}
+		succeededCmd.SetVal(int64(1))
+		mockClient.On("Eval", ctx, promoteScript, mock.Anything, mock.Anything).
+			Run(func(_ mock.Arguments) { cancel() }).
+			Return(succeededCmd)
+
+		// Act
+		br.wg.Add(1)
+		br.promoteScheduled(ctx)
+
+		// Assert
+		mockClient.AssertExpectations(t)
+		logger.AssertExpectations(t)
+	})
+}
+
 func Test_RedisBroker_Dequeue(t *testing.T) {
 	t.Run("Polls redis and returns a channel for listening", func(t *testing.T) {
 		// Arrange
-		ctx := context.Background()
+		ctx, cancel := context.WithCancel(context.Background())
 
 		mockClient := new(mockRedisClient)
 		queueKey := "queue"
@@ -144,14 +529,14 @@ func Test_RedisBroker_Dequeue(t *testing.T) {
 
 		returnedFromRedis := &redis.StringSliceCmd{}
 		returnedFromRedis.SetVal([]string{"", "returned val"})
-		mockClient.On("BRPop", ctx, time.Duration(0), []string{queueKey}).Once().Return(returnedFromRedis)
+		mockClient.On("BRPop", ctx, time.Duration(0), priorityKeys(queueKey)).Once().Return(returnedFromRedis)
 
 		deserialisedVal := task.Task{}
 		encoder.On("Deserialise", []byte(returnedFromRedis.Val()[1])).Once().Return(deserialisedVal, nil)
 
 		errReturnedFromRedis := &redis.StringSliceCmd{}
 		errReturnedFromRedis.SetErr(context.Canceled)
-		mockClient.On("BRPop", ctx, time.Duration(0), []string{queueKey}).Once().Return(errReturnedFromRedis)
+		mockClient.On("BRPop", ctx, time.Duration(0), priorityKeys(queueKey)).Once().Return(errReturnedFromRedis)
 
 		// Act
 		c := br.Dequeue(ctx)
@@ -160,13 +545,70 @@ func Test_RedisBroker_Dequeue(t *testing.T) {
 		assert.Equal(t, c, channel.NewReadOnly(br.outChan))
 		assert.Equal(t, deserialisedVal, <-c)
 
+		cancel()
+		br.wg.Wait()
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Pops the lowest priority first on every starvation guard-th dequeue", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mockClient := new(mockRedisClient)
+		queueKey := "queue"
+		encoder := new(mockEncoder[task.Task])
+
+		br := NewRedisBroker(mockClient, queueKey, encoder, WithStarvationGuard(2))
+
+		returnedFromRedis := &redis.StringSliceCmd{}
+		returnedFromRedis.SetVal([]string{"", "returned val"})
+		mockClient.On("BRPop", ctx, time.Duration(0), priorityKeys(queueKey)).Once().Return(returnedFromRedis)
+
+		encoder.On("Deserialise", []byte("returned val")).Once().Return(task.Task{}, nil)
+
+		lowestFirst := priorityKeys(queueKey)
+		slices.Reverse(lowestFirst)
+
+		errReturnedFromRedis := &redis.StringSliceCmd{}
+		errReturnedFromRedis.SetErr(context.Canceled)
+		mockClient.On("BRPop", ctx, time.Duration(0), lowestFirst).Once().Return(errReturnedFromRedis)
+
+		// Act
+		<-br.Dequeue(ctx)
+
+		cancel()
+		br.wg.Wait()
+
+		// Assert
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Pops results from a single queue", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mockClient := new(mockRedisClient)
+		encoder := new(mockEncoder[task.Result])
+
+		br := NewRedisBroker(mockClient, "results", encoder, WithSchedulePollInterval(time.Hour))
+
+		errReturnedFromRedis := &redis.StringSliceCmd{}
+		errReturnedFromRedis.SetErr(context.Canceled)
+		mockClient.On("BRPop", ctx, time.Duration(0), []string{"results"}).Once().Return(errReturnedFromRedis)
+
+		// Act
+		br.Dequeue(ctx)
+
+		cancel()
 		br.wg.Wait()
+
+		// Assert
 		mockClient.AssertExpectations(t)
 	})
 
 	t.Run("Logs Redis error and continues polling", func(t *testing.T) {
 		// Arrange
-		ctx := context.Background()
+		ctx, cancel := context.WithCancel(context.Background())
 
 		mockClient := new(mockRedisClient)
 		queueKey := "queue"
@@ -177,18 +619,19 @@ func Test_RedisBroker_Dequeue(t *testing.T) {
 
 		returnedResult := &redis.StringSliceCmd{}
 		returnedResult.SetErr(redis.ErrClosed)
-		mockClient.On("BRPop", ctx, time.Duration(0), []string{queueKey}).Once().Return(returnedResult)
+		mockClient.On("BRPop", ctx, time.Duration(0), priorityKeys(queueKey)).Once().Return(returnedResult)
 
 		logger.On("Warn", "redis: client is closed").Once()
 
 		errReturnedFromRedis := &redis.StringSliceCmd{}
 		errReturnedFromRedis.SetErr(context.Canceled)
-		mockClient.On("BRPop", ctx, time.Duration(0), []string{queueKey}).Once().Return(errReturnedFromRedis)
+		mockClient.On("BRPop", ctx, time.Duration(0), priorityKeys(queueKey)).Once().Return(errReturnedFromRedis)
 
 		// Act
 		br.Dequeue(ctx)
 
 		// Assert
+		cancel()
 		br.wg.Wait()
 
 		select {
@@ -215,7 +658,7 @@ func Test_RedisBroker_Dequeue(t *testing.T) {
 
 		returnedFromRedis := &redis.StringSliceCmd{}
 		returnedFromRedis.SetVal([]string{"", "faulty data"})
-		mockClient.On("BRPop", ctx, time.Duration(0), []string{queueKey}).Once().Return(returnedFromRedis)
+		mockClient.On("BRPop", ctx, time.Duration(0), priorityKeys(queueKey)).Once().Return(returnedFromRedis)
 
 		encoder.On("Deserialise", []byte("faulty data")).Once().Run(func(args mock.Arguments) {
 			// Cancel so that there will only be one iteration of the polling loop
@@ -226,7 +669,7 @@ func Test_RedisBroker_Dequeue(t *testing.T) {
 
 		errReturnedFromRedis := &redis.StringSliceCmd{}
 		errReturnedFromRedis.SetErr(context.Canceled)
-		mockClient.On("BRPop", ctx, time.Duration(0), []string{queueKey}).Once().Return(errReturnedFromRedis)
+		mockClient.On("BRPop", ctx, time.Duration(0), priorityKeys(queueKey)).Once().Return(errReturnedFromRedis)
 
 		// Act
 		br.Dequeue(ctx)
@@ -247,6 +690,30 @@ func Test_RedisBroker_Dequeue(t *testing.T) {
 	})
 }
 
+// priorityKeys returns the queues a task broker pops from, highest priority first.
+func priorityKeys(queueKey string) []string {
+	keys := []string{}
+
+	for p := task.MaxPriority; p > 0; p-- {
+		keys = append(keys, fmt.Sprintf("%s:p%d", queueKey, p))
+	}
+
+	return append(keys, queueKey)
+}
+
+// scheduledKeyPairs returns the scheduled set and queue for each priority level of
+// a task broker, lowest priority first.
+func scheduledKeyPairs(queueKey string) []string {
+	keys := []string{queueKey + ":scheduled", queueKey}
+
+	for p := 1; p <= task.MaxPriority; p++ {
+		key := fmt.Sprintf("%s:p%d", queueKey, p)
+		keys = append(keys, key+":scheduled", key)
+	}
+
+	return keys
+}
+
 type mockRedisClient struct {
 	mock.Mock
 }
@@ -261,6 +728,26 @@ func (m *mockRedisClient) BRPop(ctx context.Context, timeout time.Duration, keys
 	return args.Get(0).(*redis.StringSliceCmd)
 }
 
+func (m *mockRedisClient) ZAdd(ctx context.Context, key string, members ...redis.Z) *redis.IntCmd {
+	args := m.Called(ctx, key, members)
+	return args.Get(0).(*redis.IntCmd)
+}
+
+func (m *mockRedisClient) Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd {
+	called := m.Called(ctx, script, keys, args)
+	return called.Get(0).(*redis.Cmd)
+}
+
+func (m *mockRedisClient) Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd {
+	args := m.Called(ctx, key, value, expiration)
+	return args.Get(0).(*redis.StatusCmd)
+}
+
+func (m *mockRedisClient) Exists(ctx context.Context, keys ...string) *redis.IntCmd {
+	args := m.Called(ctx, keys)
+	return args.Get(0).(*redis.IntCmd)
+}
+
 type mockEncoder[T task.TaskOrResult] struct {
 	mock.Mock
 }
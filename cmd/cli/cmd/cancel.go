@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var cancelCmd = &cobra.Command{
+	Use:   "cancel [taskID]",
+	Short: "Cancel a task that has not finished",
+	Long: `Cancel a task that has not finished. A task that is still queued will not run,
+and a running task has its context cancelled. Either way its result is recorded as
+cancelled.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		goFlowService, err := newGoFlowClient()
+		if err != nil {
+			return err
+		}
+
+		err = goFlowService.Cancel(args[0])
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Cancelled task: '%s'\n", args[0])
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(cancelCmd)
+}
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/cmd/cli/internal/config"
+	"github.com/jamesTait-jt/goflow/cmd/cli/internal/k8s/grpcserver"
+	"github.com/jamesTait-jt/goflow/grpc/client"
+	"github.com/jamesTait-jt/goflow/pkg/log"
+)
+
+// newGoFlowClient connects to the GoFlow server deployed by the loaded config.
+func newGoFlowClient() (*client.GoFlowGRPCClient, error) {
+	conf, err := config.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	serverAddr := fmt.Sprintf("%s:%d", conf.GoFlowServer.Address, grpcserver.GRPCPort)
+
+	return client.NewGoFlowClient(
+		serverAddr,
+		client.WithRequestTimeout(time.Minute),
+		client.WithLogger(log.NewConsoleLogger()),
+	)
+}
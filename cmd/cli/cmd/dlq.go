@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var dlqCmd = &cobra.Command{
+	Use:   "dlq",
+	Short: "Inspect and replay dead-lettered tasks",
+	Long: `Inspect and replay dead-lettered tasks. Tasks are dead-lettered if no handler is
+registered for their type, or if they failed on their final attempt.`,
+}
+
+var dlqListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List dead-lettered tasks, oldest first",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		goFlowService, err := newGoFlowClient()
+		if err != nil {
+			return err
+		}
+
+		deadLetters, err := goFlowService.ListDeadLetters()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0) // nolint:mnd // column padding
+		fmt.Fprintln(w, "TASK ID\tTASK TYPE\tATTEMPTS\tFAILED AT\tREASON\tPAYLOAD")
+
+		for _, d := range deadLetters {
+			fmt.Fprintf(
+				w,
+				"%s\t%s\t%d\t%s\t%s\t%s\n",
+				d.GetTaskID(),
+				d.GetTaskType(),
+				d.GetAttempts(),
+				d.GetFailedAt().AsTime().Format(time.RFC3339),
+				d.GetReason(),
+				d.GetPayload(),
+			)
+		}
+
+		return w.Flush()
+	},
+}
+
+var dlqReplayCmd = &cobra.Command{
+	Use:   "replay [taskID]",
+	Short: "Push a dead-lettered task onto the task queue again",
+	Long: `Push a dead-lettered task onto the task queue again, with the same type, payload,
+priority and timeout. The replayed task gets a new ID.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		goFlowService, err := newGoFlowClient()
+		if err != nil {
+			return err
+		}
+
+		replayedID, err := goFlowService.ReplayDeadLetter(args[0])
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("TaskID: '%s'\n", replayedID)
+
+		return nil
+	},
+}
+
+var dlqPurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Remove every dead-lettered task",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		goFlowService, err := newGoFlowClient()
+		if err != nil {
+			return err
+		}
+
+		purged, err := goFlowService.PurgeDeadLetters()
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Purged %d dead letters\n", purged)
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(dlqCmd)
+	dlqCmd.AddCommand(dlqListCmd, dlqReplayCmd, dlqPurgeCmd)
+}
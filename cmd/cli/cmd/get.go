@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -9,6 +10,13 @@ import (
 	"github.com/jamesTait-jt/goflow/grpc/client"
 	"github.com/jamesTait-jt/goflow/pkg/log"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	getStatus      bool
+	getWait        bool
+	getWaitTimeout time.Duration
 )
 
 var getCmd = &cobra.Command{
@@ -33,7 +41,11 @@ var getCmd = &cobra.Command{
 			return err
 		}
 
-		taskResult, err := goFlowService.Get(args[0])
+		if getStatus {
+			return printStatus(cmd, goFlowService, args[0])
+		}
+
+		taskResult, err := getResult(cmd, goFlowService, args[0])
 		if err != nil {
 			return err
 		}
@@ -44,6 +56,61 @@ var getCmd = &cobra.Command{
 	},
 }
 
+// getResult fetches the result of the task, first waiting for it to finish if --wait
+// is set.
+func getResult(cmd *cobra.Command, goFlowService *client.GoFlowGRPCClient, taskID string) (string, error) {
+	if !getWait {
+		return goFlowService.Get(taskID)
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if getWaitTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, getWaitTimeout)
+		defer cancel()
+	}
+
+	return goFlowService.Wait(ctx, taskID)
+}
+
+func printStatus(cmd *cobra.Command, goFlowService *client.GoFlowGRPCClient, taskID string) error {
+	status, err := goFlowService.GetStatus(taskID)
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("Task status: '%s' (attempt %d)\n", status.GetState(), status.GetAttempt())
+
+	for _, ts := range []struct {
+		name string
+		at   *timestamppb.Timestamp
+	}{
+		{"Enqueued", status.GetEnqueuedAt()},
+		{"Started", status.GetStartedAt()},
+		{"Finished", status.GetFinishedAt()},
+	} {
+		if ts.at != nil {
+			cmd.Printf("%s at: %s\n", ts.name, ts.at.AsTime().Format(time.RFC3339))
+		}
+	}
+
+	if len(status.GetMetadata()) > 0 {
+		cmd.Printf("Metadata: %s\n", formatMetadata(status.GetMetadata()))
+	}
+
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(getCmd)
+
+	getCmd.Flags().BoolVar(&getStatus, "status", false, "show the lifecycle status of the task instead of its result")
+	getCmd.Flags().BoolVar(&getWait, "wait", false, "block until the task has finished")
+	getCmd.Flags().DurationVar(&getWaitTimeout, "timeout", 0, "give up waiting after this long (e.g. 30s), 0 waits indefinitely")
+	getCmd.MarkFlagsMutuallyExclusive("status", "wait")
 }
@@ -1,21 +1,93 @@
 package cmd
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"os"
+	"slices"
 	"time"
 
 	"github.com/jamesTait-jt/goflow/cmd/cli/internal/config"
 	"github.com/jamesTait-jt/goflow/cmd/cli/internal/k8s/grpcserver"
 	"github.com/jamesTait-jt/goflow/grpc/client"
 	"github.com/jamesTait-jt/goflow/pkg/log"
+	"github.com/jamesTait-jt/goflow/task"
 	"github.com/spf13/cobra"
 )
 
+var (
+	pushTimeout  time.Duration
+	pushAt       string
+	pushAfter    time.Duration
+	pushPriority int
+	pushFile     string
+	pushIdemKey  string
+	pushMetadata map[string]string
+	pushTaskType string
+)
+
+const (
+	// pushBatchSize is how many tasks from --file are sent in each request.
+	pushBatchSize = 500
+
+	// maxPushFileLineSize is the longest line accepted in a --file.
+	maxPushFileLineSize = 1 << 20
+)
+
+// fileTask is a single line of a file passed to --file.
+type fileTask struct {
+	TaskType string          `json:"taskType"`
+	Payload  json.RawMessage `json:"payload"`
+	Priority *int            `json:"priority,omitempty"`
+
+	IdempotencyKey string            `json:"idempotencyKey,omitempty"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+}
+
+// fileRequest is a single line of a file passed to --file with --task-type, in the
+// format of requests.jsonl. The whole line is the task's payload.
+type fileRequest struct {
+	RequestID string `json:"request_id"`
+}
+
 var pushCmd = &cobra.Command{
-	Use:   "push",
+	Use:   "push [taskType] [payload]",
 	Short: "Push a task to the workerpool",
+	Long: `Push a task to the workerpool.
+
+With --file, every task in a JSON Lines file is pushed instead, in batches. Each
+line is an object such as {"taskType": "resize", "payload": {"id": 1}}, with an
+optional "priority" that overrides --priority, an optional "idempotencyKey" and
+optional "metadata", which is merged over --metadata.
+
+With --task-type as well, the file is in the format of requests.jsonl instead:
+each line is an object such as {"request_id": "user-001", "title": "...",
+"body": "..."}, which is pushed whole as the payload of a task of that type. A
+line's "request_id" is used as its idempotency key, so the file can be pushed
+again without duplicating tasks.
+
+A task pushed with an idempotency key is only pushed once within the server's
+idempotency window, so a push that timed out can be safely repeated: repeats
+return the ID of the original task.`,
 	Args: func(cmd *cobra.Command, args []string) error {
+		if pushAt != "" && pushAfter > 0 {
+			return fmt.Errorf("only one of --at and --after may be set")
+		}
+
+		if pushTaskType != "" && pushFile == "" {
+			return fmt.Errorf("--task-type can only be used with --file")
+		}
+
+		if pushFile != "" {
+			if pushIdemKey != "" {
+				return fmt.Errorf("--idempotency-key cannot be used with --file, set idempotencyKey on each line instead")
+			}
+
+			return cobra.NoArgs(cmd, args)
+		}
+
 		numRequiredArgs := 2
 
 		if err := cobra.ExactArgs(numRequiredArgs)(cmd, args); err != nil {
@@ -46,7 +118,42 @@ var pushCmd = &cobra.Command{
 			return err
 		}
 
-		taskID, err := goFlowService.Push(args[0], args[1])
+		var pushOpts []client.PushOption
+
+		if pushTimeout > 0 {
+			pushOpts = append(pushOpts, client.WithTaskTimeout(pushTimeout))
+		}
+
+		if pushAt != "" {
+			runAt, err := time.Parse(time.RFC3339, pushAt)
+			if err != nil {
+				return fmt.Errorf("--at must be an RFC3339 time: %w", err)
+			}
+
+			pushOpts = append(pushOpts, client.WithRunAt(runAt))
+		}
+
+		if pushAfter > 0 {
+			pushOpts = append(pushOpts, client.WithDelay(pushAfter))
+		}
+
+		if pushPriority != 0 {
+			pushOpts = append(pushOpts, client.WithPriority(pushPriority))
+		}
+
+		if len(pushMetadata) > 0 {
+			pushOpts = append(pushOpts, client.WithMetadata(pushMetadata))
+		}
+
+		if pushFile != "" {
+			return pushFromFile(cmd, goFlowService, pushFile, pushOpts)
+		}
+
+		if pushIdemKey != "" {
+			pushOpts = append(pushOpts, client.WithIdempotencyKey(pushIdemKey))
+		}
+
+		taskID, err := goFlowService.Push(args[0], args[1], pushOpts...)
 		if err != nil {
 			return err
 		}
@@ -57,6 +164,129 @@ var pushCmd = &cobra.Command{
 	},
 }
 
+// pushFromFile pushes the tasks in a JSON Lines file, reading it a batch at a time.
+func pushFromFile(cmd *cobra.Command, goFlowService *client.GoFlowGRPCClient, path string, pushOpts []client.PushOption) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	pushed := 0
+	batch := make([]client.PushRequest, 0, pushBatchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		ids, err := goFlowService.PushBatch(batch)
+		if err != nil {
+			return fmt.Errorf("pushed %d tasks before failing: %w", pushed, err)
+		}
+
+		for _, id := range ids {
+			cmd.Printf("TaskID: '%s'\n", id)
+		}
+
+		pushed += len(ids)
+		batch = batch[:0]
+
+		return nil
+	}
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxPushFileLineSize)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		req, err := parseFileLine(text, pushOpts)
+		if err != nil {
+			return fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+
+		batch = append(batch, req)
+
+		if len(batch) == pushBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+
+	cmd.Printf("Pushed %d tasks\n", pushed)
+
+	return nil
+}
+
+// parseFileLine parses a line of a --file into the task to push, in the format
+// selected by --task-type.
+func parseFileLine(text []byte, pushOpts []client.PushOption) (client.PushRequest, error) {
+	opts := slices.Clip(pushOpts)
+
+	if pushTaskType != "" {
+		var fr fileRequest
+		if err := json.Unmarshal(text, &fr); err != nil {
+			return client.PushRequest{}, err
+		}
+
+		if fr.RequestID != "" {
+			opts = append(opts, client.WithIdempotencyKey(fr.RequestID))
+		}
+
+		return client.PushRequest{TaskType: pushTaskType, Payload: string(text), Opts: opts}, nil
+	}
+
+	var ft fileTask
+	if err := json.Unmarshal(text, &ft); err != nil {
+		return client.PushRequest{}, err
+	}
+
+	if ft.TaskType == "" {
+		return client.PushRequest{}, fmt.Errorf("taskType is required, or use --task-type for a file in the format of requests.jsonl")
+	}
+
+	payload := "null"
+	if len(ft.Payload) > 0 {
+		payload = string(ft.Payload)
+	}
+
+	if ft.Priority != nil {
+		opts = append(opts, client.WithPriority(*ft.Priority))
+	}
+
+	if ft.IdempotencyKey != "" {
+		opts = append(opts, client.WithIdempotencyKey(ft.IdempotencyKey))
+	}
+
+	if len(ft.Metadata) > 0 {
+		opts = append(opts, client.WithMetadata(ft.Metadata))
+	}
+
+	return client.PushRequest{TaskType: ft.TaskType, Payload: payload, Opts: opts}, nil
+}
+
 func init() {
 	rootCmd.AddCommand(pushCmd)
+
+	pushCmd.Flags().DurationVar(&pushTimeout, "timeout", 0, "maximum time the task may run for (e.g. 30s)")
+	pushCmd.Flags().StringVar(&pushAt, "at", "", "time to run the task at, in RFC3339 format (e.g. 2024-01-02T02:00:00Z)")
+	pushCmd.Flags().DurationVar(&pushAfter, "after", 0, "delay before the task is run (e.g. 10m)")
+	pushCmd.Flags().StringVar(&pushFile, "file", "", "push every task in a JSON Lines file instead of a single task")
+	pushCmd.Flags().StringVar(&pushTaskType, "task-type", "", "with --file, push each line of a file in the format of requests.jsonl as a task of this type")
+	pushCmd.Flags().StringVar(&pushIdemKey, "idempotency-key", "", "push the task only if no task was pushed with this key recently")
+	pushCmd.Flags().IntVar(&pushPriority, "priority", 0, fmt.Sprintf("priority from 0 to %d; higher priorities run first", task.MaxPriority))
+	pushCmd.Flags().StringToStringVar(&pushMetadata, "metadata", nil, "metadata to attach to the task (e.g. trace_id=abc,tenant=acme)")
 }
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/grpc/client"
+	pb "github.com/jamesTait-jt/goflow/grpc/proto"
+	"github.com/spf13/cobra"
+)
+
+var (
+	resultsType     string
+	resultsState    string
+	resultsSince    time.Duration
+	resultsUntil    time.Duration
+	resultsLimit    int
+	resultsMetadata map[string]string
+)
+
+var resultsCmd = &cobra.Command{
+	Use:   "results",
+	Short: "Inspect the results of finished tasks",
+}
+
+var resultsListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the stored results of finished tasks",
+	Long: `List the stored results of finished tasks, optionally filtered by task type, the
+state the task finished in, when it finished and its metadata. The results store
+must support listing, which the in-memory, Redis and file stores do.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if resultsLimit < 0 {
+			return fmt.Errorf("--limit must not be negative")
+		}
+
+		goFlowService, err := newGoFlowClient()
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		filter := client.ResultFilter{TaskType: resultsType, State: resultsState, Metadata: resultsMetadata}
+
+		if resultsSince > 0 {
+			filter.FinishedAfter = now.Add(-resultsSince)
+		}
+
+		if resultsUntil > 0 {
+			filter.FinishedBefore = now.Add(-resultsUntil)
+		}
+
+		var (
+			results []*pb.TaskResult
+			cursor  string
+		)
+
+		for {
+			page, next, err := goFlowService.ListResults(filter, cursor, 0)
+			if err != nil {
+				return err
+			}
+
+			results = append(results, page...)
+			cursor = next
+
+			if cursor == "" || (resultsLimit > 0 && len(results) >= resultsLimit) {
+				break
+			}
+		}
+
+		if resultsLimit > 0 && len(results) > resultsLimit {
+			results = results[:resultsLimit]
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0) // nolint:mnd // column padding
+		fmt.Fprintln(w, "TASK ID\tTASK TYPE\tSTATE\tATTEMPT\tFINISHED AT\tMETADATA\tRESULT\tERROR")
+
+		for _, r := range results {
+			finishedAt := ""
+			if r.GetFinishedAt() != nil {
+				finishedAt = r.GetFinishedAt().AsTime().Format(time.RFC3339)
+			}
+
+			fmt.Fprintf(
+				w,
+				"%s\t%s\t%s\t%d\t%s\t%s\t%s\t%s\n",
+				r.GetTaskID(),
+				r.GetTaskType(),
+				r.GetState(),
+				r.GetAttempt(),
+				finishedAt,
+				formatMetadata(r.GetMetadata()),
+				r.GetResult(),
+				r.GetErrMsg(),
+			)
+		}
+
+		return w.Flush()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(resultsCmd)
+	resultsCmd.AddCommand(resultsListCmd)
+
+	resultsListCmd.Flags().StringVar(&resultsType, "type", "", "only list results of this task type")
+	resultsListCmd.Flags().StringVar(&resultsState, "state", "", "only list tasks that finished in this state: succeeded, failed or cancelled")
+	resultsListCmd.Flags().DurationVar(&resultsSince, "since", 0, "only list tasks that finished within this long ago (e.g. 1h)")
+	resultsListCmd.Flags().DurationVar(&resultsUntil, "until", 0, "only list tasks that finished more than this long ago (e.g. 10m)")
+	resultsListCmd.Flags().IntVar(&resultsLimit, "limit", 0, "list at most this many results, 0 lists them all")
+	resultsListCmd.Flags().StringToStringVar(&resultsMetadata, "metadata", nil, "only list results with this metadata (e.g. tenant=acme)")
+}
+
+// formatMetadata formats metadata as comma-separated key=value pairs, sorted by key.
+func formatMetadata(metadata map[string]string) string {
+	pairs := make([]string, 0, len(metadata))
+	for _, key := range slices.Sorted(maps.Keys(metadata)) {
+		pairs = append(pairs, key+"="+metadata[key])
+	}
+
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	scheduleCron  string
+	scheduleEvery time.Duration
+)
+
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Manage recurring tasks",
+}
+
+var scheduleAddCmd = &cobra.Command{
+	Use:   "add [taskType] [payload]",
+	Short: "Push a task on a recurring schedule",
+	Args: func(cmd *cobra.Command, args []string) error {
+		numRequiredArgs := 2
+
+		if err := cobra.ExactArgs(numRequiredArgs)(cmd, args); err != nil {
+			return err
+		}
+
+		if !json.Valid([]byte(args[1])) {
+			return fmt.Errorf("payload must be a string in json format")
+		}
+
+		if (scheduleCron == "") == (scheduleEvery == 0) {
+			return fmt.Errorf("exactly one of --cron and --every must be set")
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		goFlowService, err := newGoFlowClient()
+		if err != nil {
+			return err
+		}
+
+		spec := scheduleCron
+		if scheduleEvery > 0 {
+			spec = fmt.Sprintf("@every %s", scheduleEvery)
+		}
+
+		scheduleID, err := goFlowService.AddSchedule(args[0], args[1], spec)
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("ScheduleID: '%s'\n", scheduleID)
+
+		return nil
+	},
+}
+
+var scheduleListCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List recurring tasks",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		goFlowService, err := newGoFlowClient()
+		if err != nil {
+			return err
+		}
+
+		schedules, err := goFlowService.ListSchedules()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0) // nolint:mnd // column padding
+		fmt.Fprintln(w, "ID\tTASK TYPE\tSPEC\tNEXT RUN\tPAYLOAD")
+
+		for _, s := range schedules {
+			fmt.Fprintf(
+				w,
+				"%s\t%s\t%s\t%s\t%s\n",
+				s.GetId(),
+				s.GetTaskType(),
+				s.GetSpec(),
+				s.GetNextRun().AsTime().Format(time.RFC3339),
+				s.GetPayload(),
+			)
+		}
+
+		return w.Flush()
+	},
+}
+
+var scheduleRemoveCmd = &cobra.Command{
+	Use:     "rm [scheduleID]",
+	Aliases: []string{"remove"},
+	Short:   "Remove a recurring task",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		goFlowService, err := newGoFlowClient()
+		if err != nil {
+			return err
+		}
+
+		err = goFlowService.RemoveSchedule(args[0])
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Removed schedule '%s'\n", args[0])
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(scheduleCmd)
+	scheduleCmd.AddCommand(scheduleAddCmd, scheduleListCmd, scheduleRemoveCmd)
+
+	scheduleAddCmd.Flags().StringVar(&scheduleCron, "cron", "", `cron expression in UTC (e.g. "0 2 * * *" or "@hourly")`)
+	scheduleAddCmd.Flags().DurationVar(&scheduleEvery, "every", 0, "interval between runs (e.g. 5m)")
+}
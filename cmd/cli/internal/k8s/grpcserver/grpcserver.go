@@ -43,6 +43,7 @@ func Deployment(conf *config.Config) *acappsv1.DeploymentApplyConfiguration {
 										WithArgs(
 											"--broker-type", "redis",
 											"--broker-addr", fmt.Sprintf("%s:%d", redis.ServiceName, redis.RedisPort),
+											"--results-store", "redis",
 										).
 										WithPorts(
 											acapiv1.ContainerPort().
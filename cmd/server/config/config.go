@@ -3,32 +3,72 @@ package config
 import (
 	"flag"
 	"fmt"
+	"time"
 )
 
-var defaultBrokerType = "redis"
+var (
+	defaultBrokerType   = "redis"
+	defaultResultsStore = "memory"
+)
 
-var supportedBrokerTypes = []string{"redis"}
+var (
+	supportedBrokerTypes   = []string{"redis"}
+	supportedResultsStores = []string{"memory", "redis"}
+)
 
 type Config struct {
-	BrokerType string
-	BrokerAddr string
+	BrokerType          string
+	BrokerAddr          string
+	DefaultTaskTimeout  time.Duration
+	IdempotencyWindow   time.Duration
+	ResultsStore        string
+	ResultTTL           time.Duration
+	ResultMaxEntries    int
+	ResultSweepInterval time.Duration
 }
 
 func LoadConfigFromFlags() *Config {
 	c := &Config{}
 
-	enumFlag(&c.BrokerType, "broker-type", supportedBrokerTypes, "Type of task broker (e.g. 'redis')")
+	enumFlag(&c.BrokerType, "broker-type", supportedBrokerTypes, defaultBrokerType, "Type of task broker (e.g. 'redis')")
 	flag.StringVar(&c.BrokerAddr, "broker-addr", "", "Broker address (e.g., Redis address)")
+	flag.DurationVar(&c.DefaultTaskTimeout, "default-task-timeout", 0, "Timeout applied to tasks pushed without one (e.g. 30s)")
+	positiveDurationFlag(&c.IdempotencyWindow, "idempotency-window", 24*time.Hour, "How long idempotency keys are remembered, which must be positive (e.g. 1h)") // nolint:mnd // one day
+	enumFlag(&c.ResultsStore, "results-store", supportedResultsStores, defaultResultsStore, "Where results are kept: 'memory' for this replica only, or 'redis' to share them between replicas")
+	flag.DurationVar(&c.ResultTTL, "result-ttl", 0, "How long results, task statuses and groups are kept before they expire, or 0 to keep them forever (e.g. 1h)")
+	flag.IntVar(&c.ResultMaxEntries, "result-max-entries", 0, "Maximum number of results, and of statuses and groups, kept in memory, evicting the least recently used, or 0 for no limit")
+	flag.DurationVar(&c.ResultSweepInterval, "result-sweep-interval", time.Minute, "How often expired results, statuses and groups are removed from memory (e.g. 1m)")
 
 	flag.Parse()
 
 	return c
 }
 
-func enumFlag(target *string, name string, allowed []string, usage string) {
+func positiveDurationFlag(target *time.Duration, name string, defaultValue time.Duration, usage string) {
+	*target = defaultValue
+
+	flag.Func(name, usage, func(flagValue string) error {
+		d, err := time.ParseDuration(flagValue)
+		if err != nil {
+			return err
+		}
+
+		if d <= 0 {
+			return fmt.Errorf("must be positive")
+		}
+
+		*target = d
+
+		return nil
+	})
+}
+
+func enumFlag(target *string, name string, allowed []string, defaultValue, usage string) {
+	*target = defaultValue
+
 	flag.Func(name, usage, func(flagValue string) error {
 		if flagValue == "" {
-			*target = defaultBrokerType
+			*target = defaultValue
 
 			return nil
 		}
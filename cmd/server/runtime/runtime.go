@@ -3,16 +3,20 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/jamesTait-jt/goflow"
 	"github.com/jamesTait-jt/goflow/broker"
 	"github.com/jamesTait-jt/goflow/cmd/server/config"
+	"github.com/jamesTait-jt/goflow/deadletter"
 	pb "github.com/jamesTait-jt/goflow/grpc/proto"
 	"github.com/jamesTait-jt/goflow/grpc/server"
+	"github.com/jamesTait-jt/goflow/idempotency"
 	"github.com/jamesTait-jt/goflow/pkg/log"
 	"github.com/jamesTait-jt/goflow/pkg/serialise"
 	"github.com/jamesTait-jt/goflow/pkg/shutdown"
 	"github.com/jamesTait-jt/goflow/pkg/store"
+	"github.com/jamesTait-jt/goflow/schedule"
 	"github.com/jamesTait-jt/goflow/task"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
@@ -47,18 +51,38 @@ func (r *Runtime) Run(ctx context.Context) error {
 		serialise.NewGobSerialiser[task.Task](),
 		broker.WithLogger(logger),
 	)
+	resultsEncoder := serialise.NewGobSerialiser[task.Result]()
 	resultsGetter := broker.NewRedisBroker(
 		redisClient,
 		"results",
-		serialise.NewGobSerialiser[task.Result](),
+		resultsEncoder,
 		broker.WithLogger(logger),
 	)
-	resultsStore := store.NewInMemoryKVStore[string, task.Result]()
+	resultsStore := r.newResultsStore(redisClient, resultsEncoder, logger)
+	statusStore := newSharedStore[task.Status](r.Conf, redisClient, "status", logger)
+	groupStore := newSharedStore[goflow.Group](r.Conf, redisClient, "group", logger)
+	scheduleStore := schedule.NewRedisStore(
+		redisClient,
+		"schedules",
+		serialise.NewGobSerialiser[schedule.Schedule](),
+	)
 
 	gf := goflow.New(
 		taskSubmitter,
 		resultsGetter,
 		goflow.WithResultsStore(resultsStore),
+		goflow.WithResultTTL(r.Conf.ResultTTL),
+		goflow.WithStatusStore(statusStore),
+		goflow.WithGroupStore(groupStore),
+		goflow.WithDefaultTaskTimeout(r.Conf.DefaultTaskTimeout),
+		goflow.WithScheduleStore(scheduleStore),
+		goflow.WithIdempotencyStore(idempotency.NewRedisStore(redisClient, "idempotency")),
+		goflow.WithIdempotencyWindow(r.Conf.IdempotencyWindow),
+		goflow.WithDeadLetterQueue(deadletter.NewRedisQueue(
+			redisClient,
+			"deadletter",
+			serialise.NewGobSerialiser[deadletter.Entry](),
+		)),
 	)
 
 	_ = gf.Start()
@@ -74,7 +98,55 @@ func (r *Runtime) Run(ctx context.Context) error {
 		},
 	)
 
-	shutdown.AddShutdownHook(ctx, logger, grpcServer, redisClient, gf)
+	closers := []io.Closer{grpcServer, redisClient, gf}
+	for _, s := range []any{resultsStore, statusStore, groupStore} {
+		if closer, ok := s.(io.Closer); ok {
+			closers = append(closers, closer)
+		}
+	}
+
+	shutdown.AddShutdownHook(ctx, logger, closers...)
 
 	return nil
 }
+
+// newResultsStore creates the store selected by the results-store flag. Results kept
+// in memory are only visible to the replica that consumed them from the results
+// queue, so servers with more than one replica should keep them in Redis.
+func (r *Runtime) newResultsStore(
+	redisClient *redis.Client,
+	encoder store.Encoder[task.Result],
+	logger log.Logger,
+) goflow.KVStore[string, task.Result] {
+	if r.Conf.ResultsStore == "redis" {
+		return store.NewRedisKVStore(redisClient, "result", encoder, store.WithLogger(logger))
+	}
+
+	return newInMemoryStore[task.Result](r.Conf)
+}
+
+// newSharedStore creates a store for state that every replica must see, such as
+// task statuses and groups. It is shared in Redis along with results, so that any
+// replica can report on or cancel a task, or report on a group, pushed through
+// another.
+func newSharedStore[V any](
+	conf *config.Config,
+	redisClient *redis.Client,
+	prefix string,
+	logger log.Logger,
+) goflow.KVStore[string, V] {
+	if conf.ResultsStore == "redis" {
+		return store.NewRedisKVStore(redisClient, prefix, serialise.NewGobSerialiser[V](), store.WithLogger(logger))
+	}
+
+	return newInMemoryStore[V](conf)
+}
+
+// newInMemoryStore creates an in-memory store capped and swept like the results
+// store, so that anything kept with the result TTL is removed once it expires.
+func newInMemoryStore[V any](conf *config.Config) *store.InMemoryKVStore[string, V] {
+	return store.NewInMemoryKVStore[string, V](
+		store.WithMaxEntries(conf.ResultMaxEntries),
+		store.WithSweepInterval(conf.ResultSweepInterval),
+	)
+}
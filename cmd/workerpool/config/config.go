@@ -3,28 +3,124 @@ package config
 import (
 	"flag"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/ratelimit"
+	"github.com/jamesTait-jt/goflow/retry"
 )
 
 var defaultNumWorkers = 5
 
+var defaultQuarantinePeriod = 5 * time.Minute
+
 var defaultBrokerType = "redis"
 
 var supportedBrokerTypes = []string{"redis"}
 
+// BuiltinMiddleware lists the middleware that can be named with -middleware without
+// a plugin providing it.
+var BuiltinMiddleware = []string{"recover", "logging", "timing"}
+
 type Config struct {
-	NumWorkers   int
-	HandlersPath string
-	BrokerType   string
-	BrokerAddr   string
+	NumWorkers        int
+	HandlersPath      string
+	BrokerType        string
+	BrokerAddr        string
+	RetryPolicies     map[string]retry.Policy
+	RateLimits        map[string]ratelimit.Limit
+	ConcurrencyLimits map[string]int
+	Middleware        []string
+	PanicThreshold    int
+	QuarantinePeriod  time.Duration
 }
 
 func LoadConfigFromFlags() *Config {
-	c := &Config{}
+	c := &Config{
+		RetryPolicies:     map[string]retry.Policy{},
+		RateLimits:        map[string]ratelimit.Limit{},
+		ConcurrencyLimits: map[string]int{},
+	}
 
 	flag.IntVar(&c.NumWorkers, "num-workers", defaultNumWorkers, "Number of workers in the pool")
 	flag.StringVar(&c.HandlersPath, "handlers-path", "", "Path to the location of the handler plugins")
 	enumFlag(&c.BrokerType, "broker-type", supportedBrokerTypes, "Type of task broker (e.g. 'redis')")
 	flag.StringVar(&c.BrokerAddr, "broker-addr", "", "Broker address (e.g., Redis address)")
+	flag.IntVar(
+		&c.PanicThreshold,
+		"panic-quarantine-threshold",
+		0,
+		"Number of panics after which a task type is quarantined, 0 to never quarantine",
+	)
+	flag.DurationVar(
+		&c.QuarantinePeriod,
+		"panic-quarantine-period",
+		defaultQuarantinePeriod,
+		"How long a task type is quarantined for",
+	)
+	flag.Func(
+		"retry-policy",
+		"Retry policy for a task type, may be repeated (e.g. 'resize=5:exponential:1s')",
+		func(flagValue string) error {
+			taskType, policy, err := parseRetryPolicy(flagValue)
+			if err != nil {
+				return err
+			}
+
+			c.RetryPolicies[taskType] = policy
+
+			return nil
+		},
+	)
+
+	flag.Func(
+		"rate-limit",
+		"Rate limit for a task type in executions per second, may be repeated (e.g. 'resize=10:20')",
+		func(flagValue string) error {
+			taskType, limit, err := parseRateLimit(flagValue)
+			if err != nil {
+				return err
+			}
+
+			c.RateLimits[taskType] = limit
+
+			return nil
+		},
+	)
+
+	flag.Func(
+		"concurrency-limit",
+		"Maximum number of tasks of a type this pool runs at once, may be repeated (e.g. 'video-encode=2')",
+		func(flagValue string) error {
+			taskType, limit, err := parseConcurrencyLimit(flagValue)
+			if err != nil {
+				return err
+			}
+
+			c.ConcurrencyLimits[taskType] = limit
+
+			return nil
+		},
+	)
+
+	flag.Func(
+		"middleware",
+		fmt.Sprintf(
+			"Comma separated middleware to wrap every handler with, outermost first, from %v or the name of a plugin exporting NewMiddleware",
+			BuiltinMiddleware,
+		),
+		func(flagValue string) error {
+			middleware, err := parseMiddleware(flagValue)
+			if err != nil {
+				return err
+			}
+
+			c.Middleware = middleware
+
+			return nil
+		},
+	)
 
 	flag.Parse()
 
@@ -49,3 +145,124 @@ func enumFlag(target *string, name string, allowed []string, usage string) {
 		return fmt.Errorf("must be one of %v", allowed)
 	})
 }
+
+// parseRetryPolicy parses a policy of the form
+// <task-type>=<max-attempts>[:<fixed|exponential|jittered>:<delay>]. Jittered
+// backoff randomises an exponential backoff.
+func parseRetryPolicy(flagValue string) (string, retry.Policy, error) {
+	taskType, spec, ok := strings.Cut(flagValue, "=")
+	if !ok || taskType == "" {
+		return "", retry.Policy{}, fmt.Errorf("retry policy must be of the form <task-type>=<max-attempts>[:<backoff>:<delay>]")
+	}
+
+	parts := strings.Split(spec, ":")
+
+	maxAttempts, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return "", retry.Policy{}, fmt.Errorf("invalid max attempts %q: %w", parts[0], err)
+	}
+
+	if maxAttempts < 1 {
+		return "", retry.Policy{}, fmt.Errorf("max attempts must be at least 1, got %d", maxAttempts)
+	}
+
+	policy := retry.Policy{MaxAttempts: maxAttempts}
+
+	switch len(parts) {
+	case 1:
+		return taskType, policy, nil
+
+	case 3: // nolint:mnd // <max-attempts>:<backoff>:<delay>
+		delay, err := time.ParseDuration(parts[2])
+		if err != nil {
+			return "", retry.Policy{}, fmt.Errorf("invalid retry delay %q: %w", parts[2], err)
+		}
+
+		switch parts[1] {
+		case "fixed":
+			policy.Backoff = retry.Fixed(delay)
+		case "exponential":
+			policy.Backoff = retry.Exponential(delay, 0)
+		case "jittered":
+			policy.Backoff = retry.Jittered(retry.Exponential(delay, 0))
+		default:
+			return "", retry.Policy{}, fmt.Errorf("backoff must be one of [fixed exponential jittered]")
+		}
+
+		return taskType, policy, nil
+
+	default:
+		return "", retry.Policy{}, fmt.Errorf("retry policy must be of the form <task-type>=<max-attempts>[:<backoff>:<delay>]")
+	}
+}
+
+// parseRateLimit parses a limit of the form <task-type>=<rate>[:<burst>], where rate
+// is the number of executions per second. The burst defaults to one.
+func parseRateLimit(flagValue string) (string, ratelimit.Limit, error) {
+	taskType, spec, ok := strings.Cut(flagValue, "=")
+	if !ok || taskType == "" {
+		return "", ratelimit.Limit{}, fmt.Errorf("rate limit must be of the form <task-type>=<rate>[:<burst>]")
+	}
+
+	rateSpec, burstSpec, hasBurst := strings.Cut(spec, ":")
+
+	rate, err := strconv.ParseFloat(rateSpec, 64)
+	if err != nil {
+		return "", ratelimit.Limit{}, fmt.Errorf("invalid rate %q: %w", rateSpec, err)
+	}
+
+	limit := ratelimit.Limit{Rate: rate, Burst: 1}
+
+	if err = limit.Validate(); err != nil {
+		return "", ratelimit.Limit{}, err
+	}
+
+	if hasBurst {
+		limit.Burst, err = strconv.Atoi(burstSpec)
+		if err != nil {
+			return "", ratelimit.Limit{}, fmt.Errorf("invalid burst %q: %w", burstSpec, err)
+		}
+
+		if limit.Burst < 1 {
+			return "", ratelimit.Limit{}, fmt.Errorf("burst must be at least 1")
+		}
+	}
+
+	return taskType, limit, nil
+}
+
+// parseConcurrencyLimit parses a limit of the form <task-type>=<max-concurrent>.
+func parseConcurrencyLimit(flagValue string) (string, int, error) {
+	taskType, spec, ok := strings.Cut(flagValue, "=")
+	if !ok || taskType == "" {
+		return "", 0, fmt.Errorf("concurrency limit must be of the form <task-type>=<max-concurrent>")
+	}
+
+	limit, err := strconv.Atoi(spec)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid concurrency limit %q: %w", spec, err)
+	}
+
+	if limit < 1 {
+		return "", 0, fmt.Errorf("concurrency limit must be at least 1")
+	}
+
+	return taskType, limit, nil
+}
+
+// parseMiddleware parses a comma separated list of middleware names. Names that are
+// not built in are checked against the loaded plugins once they have been loaded.
+func parseMiddleware(flagValue string) ([]string, error) {
+	var middleware []string
+
+	for _, name := range strings.Split(flagValue, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("middleware names must not be empty")
+		}
+
+		middleware = append(middleware, name)
+	}
+
+	return middleware, nil
+}
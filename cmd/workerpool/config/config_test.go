@@ -1,3 +1,183 @@
 //go:build unit
 
 package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/ratelimit"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseRetryPolicy(t *testing.T) {
+	t.Run("Parses a policy without backoff", func(t *testing.T) {
+		// Act
+		taskType, policy, err := parseRetryPolicy("resize=5")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "resize", taskType)
+		assert.Equal(t, 5, policy.MaxAttempts)
+		assert.Nil(t, policy.Backoff)
+	})
+
+	t.Run("Parses a policy with backoff", func(t *testing.T) {
+		type backoffTest struct {
+			spec      string
+			wantDelay time.Duration
+		}
+
+		for _, tt := range []backoffTest{
+			{"resize=5:fixed:1s", time.Second},
+			{"resize=5:exponential:1s", 4 * time.Second},
+		} {
+			t.Run(tt.spec, func(t *testing.T) {
+				// Act
+				taskType, policy, err := parseRetryPolicy(tt.spec)
+
+				// Assert
+				assert.NoError(t, err)
+				assert.Equal(t, "resize", taskType)
+				assert.Equal(t, 5, policy.MaxAttempts)
+				assert.Equal(t, tt.wantDelay, policy.Delay(3))
+			})
+		}
+	})
+
+	t.Run("Parses a jittered policy", func(t *testing.T) {
+		// Act
+		_, policy, err := parseRetryPolicy("resize=5:jittered:1s")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, policy.Delay(3), 4*time.Second)
+	})
+
+	t.Run("Returns an error for malformed policies", func(t *testing.T) {
+		for _, spec := range []string{
+			"resize",
+			"=5",
+			"resize=five",
+			"resize=0",
+			"resize=-1",
+			"resize=NaN",
+			"resize=-1:fixed:1s",
+			"resize=5:fixed",
+			"resize=5:linear:1s",
+			"resize=5:fixed:soon",
+		} {
+			t.Run(spec, func(t *testing.T) {
+				// Act
+				_, _, err := parseRetryPolicy(spec)
+
+				// Assert
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_parseRateLimit(t *testing.T) {
+	t.Run("Parses a limit", func(t *testing.T) {
+		for _, tt := range []struct {
+			spec string
+			want ratelimit.Limit
+		}{
+			{"resize=10", ratelimit.Limit{Rate: 10, Burst: 1}},
+			{"resize=0.5:20", ratelimit.Limit{Rate: 0.5, Burst: 20}},
+		} {
+			t.Run(tt.spec, func(t *testing.T) {
+				// Act
+				taskType, limit, err := parseRateLimit(tt.spec)
+
+				// Assert
+				assert.NoError(t, err)
+				assert.Equal(t, "resize", taskType)
+				assert.Equal(t, tt.want, limit)
+			})
+		}
+	})
+
+	t.Run("Returns an error for malformed limits", func(t *testing.T) {
+		for _, spec := range []string{
+			"resize",
+			"=10",
+			"resize=ten",
+			"resize=0",
+			"resize=-1",
+			"resize=NaN",
+			"resize=10:many",
+			"resize=10:0",
+		} {
+			t.Run(spec, func(t *testing.T) {
+				// Act
+				_, _, err := parseRateLimit(spec)
+
+				// Assert
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_parseConcurrencyLimit(t *testing.T) {
+	t.Run("Parses a limit", func(t *testing.T) {
+		// Act
+		taskType, limit, err := parseConcurrencyLimit("video-encode=2")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "video-encode", taskType)
+		assert.Equal(t, 2, limit)
+	})
+
+	t.Run("Returns an error for malformed limits", func(t *testing.T) {
+		for _, spec := range []string{
+			"video-encode",
+			"=2",
+			"video-encode=two",
+			"video-encode=0",
+		} {
+			t.Run(spec, func(t *testing.T) {
+				// Act
+				_, _, err := parseConcurrencyLimit(spec)
+
+				// Assert
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_parseMiddleware(t *testing.T) {
+	t.Run("Parses middleware in order", func(t *testing.T) {
+		// Act
+		middleware, err := parseMiddleware("recover, logging,timing")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"recover", "logging", "timing"}, middleware)
+	})
+
+	t.Run("Parses the names of plugin middleware", func(t *testing.T) {
+		// Act
+		middleware, err := parseMiddleware("recover,auth")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"recover", "auth"}, middleware)
+	})
+
+	t.Run("Returns an error for empty middleware names", func(t *testing.T) {
+		for _, spec := range []string{"", "recover,", "recover, ,timing"} {
+			t.Run(spec, func(t *testing.T) {
+				// Act
+				_, err := parseMiddleware(spec)
+
+				// Assert
+				assert.Error(t, err)
+			})
+		}
+	})
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"plugin"
+	"time"
 
 	"github.com/jamesTait-jt/goflow/cmd/workerpool/config"
 	"github.com/jamesTait-jt/goflow/cmd/workerpool/pluginloader"
 	"github.com/jamesTait-jt/goflow/cmd/workerpool/service"
 	"github.com/jamesTait-jt/goflow/cmd/workerpool/taskhandlers"
+	"github.com/jamesTait-jt/goflow/deadletter"
+	"github.com/jamesTait-jt/goflow/middleware"
 	"github.com/jamesTait-jt/goflow/pkg/log"
 	"github.com/jamesTait-jt/goflow/pkg/serialise"
+	"github.com/jamesTait-jt/goflow/ratelimit"
 	"github.com/jamesTait-jt/goflow/task"
 	"github.com/jamesTait-jt/goflow/workerpool"
 	"github.com/redis/go-redis/v9"
@@ -29,20 +33,23 @@ func New() *Runtime {
 
 func (r *Runtime) Run() error {
 	fmt.Printf("workerpool started with config: %v\n", r.Conf)
-	pool := workerpool.New(r.Conf.NumWorkers)
 
 	pluginLoader := pluginloader.New(afero.NewOsFs(), plugin.Open)
 
-	taskHandlers, err := taskhandlers.Load(pluginLoader, r.Conf.HandlersPath)
+	taskHandlers, pluginMiddleware, err := taskhandlers.Load(pluginLoader, r.Conf.HandlersPath)
 	if err != nil {
 		return err
 	}
 
 	logger := log.NewConsoleLogger()
 
+	chain, err := middlewareChain(r.Conf.Middleware, pluginMiddleware, logger)
+	if err != nil {
+		return err
+	}
+
 	resultSerialiser := serialise.NewGobSerialiser[task.Result]()
 	taskSerialiser := serialise.NewGobSerialiser[task.Task]()
-	serviceFactory := service.NewFactory(pool, taskSerialiser, resultSerialiser, taskHandlers, logger)
 
 	var workerpoolService *service.WorkerpoolService
 
@@ -60,6 +67,19 @@ func (r *Runtime) Run() error {
 
 		fmt.Printf("redis connection successful: %s\n", pong)
 
+		// Rate limits are shared by every workerpool replica connected to the same Redis,
+		// and dead letters with the server so that they can be listed and replayed
+		pool := r.newPool(
+			chain,
+			workerpool.WithRateLimiter(ratelimit.NewRedisLimiter(client, "ratelimit")),
+			workerpool.WithDeadLetterQueue(deadletter.NewRedisQueue(
+				client,
+				"deadletter",
+				serialise.NewGobSerialiser[deadletter.Entry](),
+			)),
+		)
+		serviceFactory := service.NewFactory(pool, taskSerialiser, resultSerialiser, taskHandlers, logger)
+
 		workerpoolService = serviceFactory.CreateRedisWorkerpoolService(client)
 	}
 
@@ -70,3 +90,52 @@ func (r *Runtime) Run() error {
 
 	return nil
 }
+
+func (r *Runtime) newPool(chain []task.Middleware, opts ...workerpool.PoolOption) *workerpool.Pool {
+	opts = append(
+		opts,
+		workerpool.WithRetryPolicies(r.Conf.RetryPolicies),
+		workerpool.WithRateLimits(r.Conf.RateLimits),
+		workerpool.WithConcurrencyLimits(r.Conf.ConcurrencyLimits),
+		workerpool.WithMiddleware(chain...),
+		workerpool.WithPanicQuarantine(r.Conf.PanicThreshold, r.Conf.QuarantinePeriod),
+	)
+
+	return workerpool.New(r.Conf.NumWorkers, opts...)
+}
+
+// middlewareChain returns the middleware with the given names, in order. Each name is
+// either built in or the name of a plugin that exports NewMiddleware.
+func middlewareChain(
+	names []string,
+	pluginMiddleware map[string]task.Middleware,
+	logger log.Logger,
+) ([]task.Middleware, error) {
+	middlewareByName := map[string]task.Middleware{
+		"recover": middleware.Recover(),
+		"logging": middleware.Logging(logger),
+		"timing": middleware.Timing(func(t task.Task, elapsed time.Duration) {
+			logger.Info(fmt.Sprintf("Task [%s] of type [%s] took %s", t.ID, t.Type, elapsed))
+		}),
+	}
+
+	var chain []task.Middleware
+
+	for _, name := range names {
+		if mw, ok := middlewareByName[name]; ok {
+			chain = append(chain, mw)
+			continue
+		}
+
+		mw, ok := pluginMiddleware[name]
+		if !ok {
+			return nil, fmt.Errorf(
+				"middleware %q is not one of %v or a plugin exporting NewMiddleware", name, config.BuiltinMiddleware,
+			)
+		}
+
+		chain = append(chain, mw)
+	}
+
+	return chain, nil
+}
@@ -8,20 +8,20 @@ import (
 )
 
 type workerpoolRunner interface {
-	Start(ctx context.Context, taskQueue task.Dequeuer[task.Task], results task.Submitter[task.Result], taskHandlers workerpool.HandlerGetter)
+	Start(ctx context.Context, taskQueue workerpool.TaskQueue, results task.Submitter[task.Result], taskHandlers workerpool.HandlerGetter)
 	AwaitShutdown()
 }
 
 type WorkerpoolService struct {
 	pool         workerpoolRunner
-	taskQueue    task.Dequeuer[task.Task]
+	taskQueue    workerpool.TaskQueue
 	resultQueue  task.Submitter[task.Result]
 	taskHandlers workerpool.HandlerGetter
 }
 
 func NewWorkerpoolService(
 	pool workerpoolRunner,
-	taskQueue task.Dequeuer[task.Task],
+	taskQueue workerpool.TaskQueue,
 	resultQueue task.Submitter[task.Result],
 	taskHandlers workerpool.HandlerGetter,
 ) *WorkerpoolService {
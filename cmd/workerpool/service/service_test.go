@@ -38,7 +38,7 @@ type mockWorkerpoolRunner struct {
 	mock.Mock
 }
 
-func (m *mockWorkerpoolRunner) Start(ctx context.Context, taskQueue task.Dequeuer[task.Task], results task.Submitter[task.Result], taskHandlers workerpool.HandlerGetter) {
+func (m *mockWorkerpoolRunner) Start(ctx context.Context, taskQueue workerpool.TaskQueue, results task.Submitter[task.Result], taskHandlers workerpool.HandlerGetter) {
 	m.Called(ctx, taskQueue, results, taskHandlers)
 }
 
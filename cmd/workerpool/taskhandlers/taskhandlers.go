@@ -1,6 +1,7 @@
 package taskhandlers
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/jamesTait-jt/goflow/cmd/workerpool/pluginloader"
@@ -12,29 +13,85 @@ type pluginLoader interface {
 	Load(pluginDir string) (map[string]pluginloader.SymbolFinder, error)
 }
 
-func Load(pluginLoader pluginLoader, pluginDir string) (*store.InMemoryKVStore[string, task.Handler], error) {
+// Load opens the plugins in pluginDir. Each plugin exports NewHandler, NewMiddleware
+// or both. A handler is stored under the plugin's name as the task type it handles,
+// and middleware is returned under the plugin's name, for the -middleware flag to
+// refer to.
+func Load(
+	pluginLoader pluginLoader,
+	pluginDir string,
+) (*store.InMemoryKVStore[string, task.Handler], map[string]task.Middleware, error) {
 	plugins, err := pluginLoader.Load(pluginDir)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+	middleware := make(map[string]task.Middleware)
 
 	for pluginName, plg := range plugins {
-		symbol, err := plg.Lookup("NewHandler")
-		if err != nil {
-			return nil, err
+		handlerSymbol, handlerErr := plg.Lookup("NewHandler")
+		middlewareSymbol, middlewareErr := plg.Lookup("NewMiddleware")
+
+		if handlerErr != nil && middlewareErr != nil {
+			return nil, nil, handlerErr
 		}
 
-		handlerFactory, ok := symbol.(func() task.Handler)
-		if !ok {
-			return nil, fmt.Errorf("invalid plugin: Handler does not implement Handler interface")
+		if handlerErr == nil {
+			handler, err := handlerFromSymbol(handlerSymbol)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			taskHandlers.Put(pluginName, handler)
 		}
 
-		handler := handlerFactory()
+		if middlewareErr == nil {
+			mw, err := middlewareFromSymbol(middlewareSymbol)
+			if err != nil {
+				return nil, nil, err
+			}
 
-		taskHandlers.Put(pluginName, handler)
+			middleware[pluginName] = mw
+		}
 	}
 
-	return taskHandlers, nil
+	return taskHandlers, middleware, nil
+}
+
+// handlerFromSymbol accepts a NewHandler factory returning either the context-aware
+// task.Handler or the legacy task.PayloadHandler. Legacy handlers are adapted with
+// task.FromPayloadHandler. Typed handlers are supported by returning
+// task.Typed(handler) as a task.Handler.
+func handlerFromSymbol(symbol any) (task.Handler, error) {
+	switch factory := symbol.(type) {
+	case func() task.Handler:
+		return factory(), nil
+
+	case func() func(context.Context, task.Task) task.Result:
+		return factory(), nil
+
+	case func() task.PayloadHandler:
+		return task.FromPayloadHandler(factory()), nil
+
+	case func() func(any) task.Result:
+		return task.FromPayloadHandler(factory()), nil
+
+	default:
+		return nil, fmt.Errorf("invalid plugin: Handler does not implement Handler interface")
+	}
+}
+
+// middlewareFromSymbol accepts a NewMiddleware factory returning a task.Middleware.
+func middlewareFromSymbol(symbol any) (task.Middleware, error) {
+	switch factory := symbol.(type) {
+	case func() task.Middleware:
+		return factory(), nil
+
+	case func() func(task.Handler) task.Handler:
+		return factory(), nil
+
+	default:
+		return nil, fmt.Errorf("invalid plugin: NewMiddleware does not return a Middleware")
+	}
 }
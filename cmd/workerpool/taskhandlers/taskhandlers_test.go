@@ -3,6 +3,7 @@
 package taskhandlers
 
 import (
+	"context"
 	"errors"
 	"plugin"
 	"testing"
@@ -35,29 +36,177 @@ func Test_Load(t *testing.T) {
 		resultOne := task.Result{TaskID: "1"}
 		resultTwo := task.Result{TaskID: "2"}
 
-		var handlerOne task.Handler = func(_ any) task.Result { return resultOne }
+		var handlerOne task.Handler = func(_ context.Context, _ task.Task) task.Result { return resultOne }
 
-		var handlerTwo task.Handler = func(_ any) task.Result { return resultTwo }
+		var handlerTwo task.Handler = func(_ context.Context, _ task.Task) task.Result { return resultTwo }
 
 		symbolOne := func() task.Handler { return handlerOne }
 		symbolTwo := func() task.Handler { return handlerTwo }
 
 		pluginOne.On("Lookup", "NewHandler").Once().Return(symbolOne, nil)
+		pluginOne.On("Lookup", "NewMiddleware").Once().Return(nil, errSymbolNotFound)
 		pluginTwo.On("Lookup", "NewHandler").Once().Return(symbolTwo, nil)
+		pluginTwo.On("Lookup", "NewMiddleware").Once().Return(nil, errSymbolNotFound)
 
 		// Act
-		handlers, err := Load(pluginLoader, pluginDir)
+		handlers, _, err := Load(pluginLoader, pluginDir)
 
 		// Assert
 		assert.NoError(t, err)
 
 		returnedHandlerOne, ok := handlers.Get(keyOne)
 		assert.True(t, ok)
-		assert.Equal(t, resultOne, returnedHandlerOne(nil))
+		assert.Equal(t, resultOne, returnedHandlerOne(context.Background(), task.Task{}))
 
 		returnedHandlerTwo, ok := handlers.Get(keyTwo)
 		assert.True(t, ok)
-		assert.Equal(t, resultTwo, returnedHandlerTwo(nil))
+		assert.Equal(t, resultTwo, returnedHandlerTwo(context.Background(), task.Task{}))
+	})
+
+	t.Run("Adapts legacy payload handlers to the context-aware signature", func(t *testing.T) {
+		// Arrange
+		pluginLoader := new(mockPluginLoader)
+		pluginDir := "plugin-dir"
+
+		keyOne := "keyOne"
+		keyTwo := "keyTwo"
+		pluginOne := &mockSymbolFinder{}
+		pluginTwo := &mockSymbolFinder{}
+
+		pluginLoader.On("Load", pluginDir).Once().Return(
+			map[string]pluginloader.SymbolFinder{
+				keyOne: pluginOne,
+				keyTwo: pluginTwo,
+			},
+			nil,
+		)
+
+		var handlerOne task.PayloadHandler = func(payload any) task.Result { return task.Result{Payload: payload} }
+
+		handlerTwo := func(payload any) task.Result { return task.Result{Payload: payload} }
+
+		symbolOne := func() task.PayloadHandler { return handlerOne }
+		symbolTwo := func() func(any) task.Result { return handlerTwo }
+
+		pluginOne.On("Lookup", "NewHandler").Once().Return(symbolOne, nil)
+		pluginOne.On("Lookup", "NewMiddleware").Once().Return(nil, errSymbolNotFound)
+		pluginTwo.On("Lookup", "NewHandler").Once().Return(symbolTwo, nil)
+		pluginTwo.On("Lookup", "NewMiddleware").Once().Return(nil, errSymbolNotFound)
+
+		// Act
+		handlers, _, err := Load(pluginLoader, pluginDir)
+
+		// Assert
+		assert.NoError(t, err)
+
+		returnedHandlerOne, ok := handlers.Get(keyOne)
+		assert.True(t, ok)
+		assert.Equal(t, task.Result{Payload: "one"}, returnedHandlerOne(context.Background(), task.Task{Payload: "one"}))
+
+		returnedHandlerTwo, ok := handlers.Get(keyTwo)
+		assert.True(t, ok)
+		assert.Equal(t, task.Result{Payload: "two"}, returnedHandlerTwo(context.Background(), task.Task{Payload: "two"}))
+	})
+
+	t.Run("Loads typed handlers returned as task.Handler", func(t *testing.T) {
+		// Arrange
+		pluginLoader := new(mockPluginLoader)
+		pluginDir := "plugin-dir"
+
+		key := "doubler"
+		plg := &mockSymbolFinder{}
+
+		pluginLoader.On("Load", pluginDir).Once().Return(map[string]pluginloader.SymbolFinder{key: plg}, nil)
+
+		double := func(_ context.Context, n int) (int, error) { return n * 2, nil }
+		symbol := func() task.Handler { return task.Typed(double) }
+
+		plg.On("Lookup", "NewHandler").Once().Return(symbol, nil)
+		plg.On("Lookup", "NewMiddleware").Once().Return(nil, errSymbolNotFound)
+
+		// Act
+		handlers, _, err := Load(pluginLoader, pluginDir)
+
+		// Assert
+		assert.NoError(t, err)
+
+		handler, ok := handlers.Get(key)
+		assert.True(t, ok)
+		assert.Equal(t, task.Result{Payload: "42"}, handler(context.Background(), task.Task{Payload: "21"}))
+	})
+
+	t.Run("Loads middleware from plugins with or without a handler", func(t *testing.T) {
+		// Arrange
+		pluginLoader := new(mockPluginLoader)
+		pluginDir := "plugin-dir"
+
+		middlewareOnly := &mockSymbolFinder{}
+		both := &mockSymbolFinder{}
+
+		pluginLoader.On("Load", pluginDir).Once().Return(
+			map[string]pluginloader.SymbolFinder{
+				"auth":    middlewareOnly,
+				"doubler": both,
+			},
+			nil,
+		)
+
+		tag := func(name string) task.Middleware {
+			return func(next task.Handler) task.Handler {
+				return func(ctx context.Context, t task.Task) task.Result {
+					r := next(ctx, t)
+					r.Payload = name
+
+					return r
+				}
+			}
+		}
+
+		var handler task.Handler = func(_ context.Context, _ task.Task) task.Result { return task.Result{} }
+
+		middlewareOnly.On("Lookup", "NewHandler").Once().Return(nil, errSymbolNotFound)
+		middlewareOnly.On("Lookup", "NewMiddleware").Once().Return(func() task.Middleware { return tag("auth") }, nil)
+		both.On("Lookup", "NewHandler").Once().Return(func() task.Handler { return handler }, nil)
+		both.On("Lookup", "NewMiddleware").Once().Return(
+			func() func(task.Handler) task.Handler { return tag("doubler") },
+			nil,
+		)
+
+		// Act
+		handlers, middleware, err := Load(pluginLoader, pluginDir)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, middleware, 2)
+		assert.Equal(t, "auth", middleware["auth"](handler)(context.Background(), task.Task{}).Payload)
+		assert.Equal(t, "doubler", middleware["doubler"](handler)(context.Background(), task.Task{}).Payload)
+
+		_, ok := handlers.Get("auth")
+		assert.False(t, ok)
+
+		_, ok = handlers.Get("doubler")
+		assert.True(t, ok)
+	})
+
+	t.Run("Returns an error if the middleware symbol is not a middleware factory", func(t *testing.T) {
+		// Arrange
+		pluginLoader := new(mockPluginLoader)
+		pluginDir := "plugin-dir"
+
+		plg := &mockSymbolFinder{}
+
+		pluginLoader.On("Load", pluginDir).Once().Return(map[string]pluginloader.SymbolFinder{"auth": plg}, nil)
+
+		plg.On("Lookup", "NewHandler").Once().Return(nil, errSymbolNotFound)
+		plg.On("Lookup", "NewMiddleware").Once().Return(func() any { return nil }, nil)
+
+		// Act
+		handlers, middleware, err := Load(pluginLoader, pluginDir)
+
+		// Assert
+		assert.EqualError(t, err, "invalid plugin: NewMiddleware does not return a Middleware")
+		assert.Nil(t, handlers)
+		assert.Nil(t, middleware)
 	})
 
 	t.Run("Returns an error if could not load the plugins", func(t *testing.T) {
@@ -69,14 +218,14 @@ func Test_Load(t *testing.T) {
 		pluginLoader.On("Load", pluginDir).Once().Return(nil, loadErr)
 
 		// Act
-		handlers, err := Load(pluginLoader, pluginDir)
+		handlers, _, err := Load(pluginLoader, pluginDir)
 
 		// Assert
 		assert.EqualError(t, err, loadErr.Error())
 		assert.Nil(t, handlers)
 	})
 
-	t.Run("Returns an error if symbol lookup fails", func(t *testing.T) {
+	t.Run("Returns an error if the plugin exports neither a handler nor middleware", func(t *testing.T) {
 		// Arrange
 		pluginLoader := new(mockPluginLoader)
 		pluginDir := "plugin-dir"
@@ -93,9 +242,10 @@ func Test_Load(t *testing.T) {
 
 		lookupErr := errors.New("couldn't lookup symbol")
 		pluginOne.On("Lookup", "NewHandler").Once().Return(nil, lookupErr)
+		pluginOne.On("Lookup", "NewMiddleware").Once().Return(nil, errSymbolNotFound)
 
 		// Act
-		handlers, err := Load(pluginLoader, pluginDir)
+		handlers, _, err := Load(pluginLoader, pluginDir)
 
 		// Assert
 		assert.EqualError(t, err, lookupErr.Error())
@@ -120,9 +270,10 @@ func Test_Load(t *testing.T) {
 		handlerOne := func() any { return nil }
 		symbolOne := func() any { return handlerOne }
 		pluginOne.On("Lookup", "NewHandler").Once().Return(symbolOne, nil)
+		pluginOne.On("Lookup", "NewMiddleware").Once().Return(nil, errSymbolNotFound)
 
 		// Act
-		handlers, err := Load(pluginLoader, pluginDir)
+		handlers, _, err := Load(pluginLoader, pluginDir)
 
 		// Assert
 		assert.EqualError(t, err, "invalid plugin: Handler does not implement Handler interface")
@@ -130,6 +281,8 @@ func Test_Load(t *testing.T) {
 	})
 }
 
+var errSymbolNotFound = errors.New("symbol not found")
+
 type mockSymbolFinder struct {
 	mock.Mock
 }
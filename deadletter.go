@@ -0,0 +1,63 @@
+package goflow
+
+import (
+	"log"
+
+	"github.com/jamesTait-jt/goflow/deadletter"
+)
+
+// ListDeadLetters returns every dead-lettered task, oldest first. Tasks are
+// dead-lettered by the worker pool if no handler is registered for their type, or
+// if they failed on their final attempt (see WithDeadLetterQueue).
+func (gf *GoFlow) ListDeadLetters() ([]deadletter.Entry, error) {
+	if !gf.isStarted() {
+		return nil, ErrNotStarted
+	}
+
+	return gf.deadLetters.List(gf.ctx)
+}
+
+// ReplayDeadLetter removes the dead letter for the task with the given ID and pushes
+// its task again, with the same type, payload, priority, timeout and metadata. The
+// replayed task gets a new ID, which is returned. It returns deadletter.ErrNotFound
+// if there is no dead letter for the task.
+func (gf *GoFlow) ReplayDeadLetter(taskID string) (string, error) {
+	if !gf.isStarted() {
+		return "", ErrNotStarted
+	}
+
+	entry, ok, err := gf.deadLetters.Take(gf.ctx, taskID)
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		return "", deadletter.ErrNotFound
+	}
+
+	opts := []PushOption{WithPriority(entry.Task.Priority), WithMetadata(entry.Task.Metadata)}
+	if entry.Task.Timeout > 0 {
+		opts = append(opts, WithTaskTimeout(entry.Task.Timeout))
+	}
+
+	replayedID, err := gf.Push(entry.Task.Type, entry.Task.Payload, opts...)
+	if err != nil {
+		// Put the dead letter back so that the replay can be tried again
+		if addErr := gf.deadLetters.Add(gf.ctx, entry); addErr != nil {
+			log.Printf("failed to restore dead letter for task %s: %v", taskID, addErr)
+		}
+
+		return "", err
+	}
+
+	return replayedID, nil
+}
+
+// PurgeDeadLetters removes every dead letter, returning how many were removed.
+func (gf *GoFlow) PurgeDeadLetters() (int, error) {
+	if !gf.isStarted() {
+		return 0, ErrNotStarted
+	}
+
+	return gf.deadLetters.Purge(gf.ctx)
+}
@@ -0,0 +1,111 @@
+// Package deadletter records tasks that could not be completed, such as tasks with
+// no registered handler and tasks that failed on their final attempt, so that they
+// can be inspected and replayed.
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+// ErrNotFound is returned when there is no dead letter for a task.
+var ErrNotFound = errors.New("dead letter not found")
+
+// An Entry is a dead-lettered task along with why it was dead-lettered.
+type Entry struct {
+	Task     task.Task
+	Reason   string
+	Attempts int
+	FailedAt time.Time
+}
+
+// Queue holds dead letters, one per task ID. Several worker pools and GoFlow
+// instances may share a queue, so Take must be atomic: it is how a replay claims an
+// entry.
+type Queue interface {
+	// Add records the entry, replacing any entry for the same task.
+	Add(ctx context.Context, e Entry) error
+
+	// List returns every entry, oldest first.
+	List(ctx context.Context) ([]Entry, error)
+
+	// Take removes the entry for the task with the given ID and returns it,
+	// reporting whether it existed.
+	Take(ctx context.Context, taskID string) (Entry, bool, error)
+
+	// Purge removes every entry, returning how many were removed.
+	Purge(ctx context.Context) (int, error)
+}
+
+// InMemoryQueue is a Queue for a single process.
+type InMemoryQueue struct {
+	entries map[string]Entry
+	mu      sync.Mutex
+}
+
+func NewInMemoryQueue() *InMemoryQueue {
+	return &InMemoryQueue{
+		entries: make(map[string]Entry),
+	}
+}
+
+func (q *InMemoryQueue) Add(_ context.Context, e Entry) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.entries[e.Task.ID] = e
+
+	return nil
+}
+
+func (q *InMemoryQueue) List(_ context.Context) ([]Entry, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	entries := make([]Entry, 0, len(q.entries))
+
+	for _, e := range q.entries {
+		entries = append(entries, e)
+	}
+
+	sortByFailedAt(entries)
+
+	return entries, nil
+}
+
+func (q *InMemoryQueue) Take(_ context.Context, taskID string) (Entry, bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	e, ok := q.entries[taskID]
+	delete(q.entries, taskID)
+
+	return e, ok, nil
+}
+
+func (q *InMemoryQueue) Purge(_ context.Context) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	purged := len(q.entries)
+	q.entries = make(map[string]Entry)
+
+	return purged, nil
+}
+
+// sortByFailedAt orders entries oldest first, breaking ties by task ID so that the
+// order is stable.
+func sortByFailedAt(entries []Entry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].FailedAt.Equal(entries[j].FailedAt) {
+			return entries[i].FailedAt.Before(entries[j].FailedAt)
+		}
+
+		return entries[i].Task.ID < entries[j].Task.ID
+	})
+}
@@ -0,0 +1,88 @@
+//go:build unit
+
+package deadletter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/task"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InMemoryQueue(t *testing.T) {
+	t.Run("Lists entries oldest first", func(t *testing.T) {
+		// Arrange
+		q := NewInMemoryQueue()
+		ctx := context.Background()
+
+		now := time.Now()
+		newer := Entry{Task: task.Task{ID: "newer"}, FailedAt: now}
+		older := Entry{Task: task.Task{ID: "older"}, FailedAt: now.Add(-time.Minute)}
+
+		_ = q.Add(ctx, newer)
+		_ = q.Add(ctx, older)
+
+		// Act
+		entries, err := q.List(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []Entry{older, newer}, entries)
+	})
+
+	t.Run("Replaces the entry for a task that is added again", func(t *testing.T) {
+		// Arrange
+		q := NewInMemoryQueue()
+		ctx := context.Background()
+
+		_ = q.Add(ctx, Entry{Task: task.Task{ID: "task-id"}, Reason: "first"})
+		_ = q.Add(ctx, Entry{Task: task.Task{ID: "task-id"}, Reason: "second"})
+
+		// Act
+		entries, _ := q.List(ctx)
+
+		// Assert
+		assert.Len(t, entries, 1)
+		assert.Equal(t, "second", entries[0].Reason)
+	})
+
+	t.Run("Takes an entry only once", func(t *testing.T) {
+		// Arrange
+		q := NewInMemoryQueue()
+		ctx := context.Background()
+
+		entry := Entry{Task: task.Task{ID: "task-id"}, Reason: "failed"}
+		_ = q.Add(ctx, entry)
+
+		// Act
+		taken, firstOK, firstErr := q.Take(ctx, "task-id")
+		_, secondOK, secondErr := q.Take(ctx, "task-id")
+
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.NoError(t, secondErr)
+		assert.True(t, firstOK)
+		assert.False(t, secondOK)
+		assert.Equal(t, entry, taken)
+	})
+
+	t.Run("Purges every entry", func(t *testing.T) {
+		// Arrange
+		q := NewInMemoryQueue()
+		ctx := context.Background()
+
+		_ = q.Add(ctx, Entry{Task: task.Task{ID: "a"}})
+		_ = q.Add(ctx, Entry{Task: task.Task{ID: "b"}})
+
+		// Act
+		purged, err := q.Purge(ctx)
+		entries, _ := q.List(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, purged)
+		assert.Empty(t, entries)
+	})
+}
@@ -0,0 +1,105 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type redisClient interface {
+	HSet(ctx context.Context, key string, values ...any) *redis.IntCmd
+	HGetAll(ctx context.Context, key string) *redis.MapStringStringCmd
+	Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd
+}
+
+// takeScript removes the entry for task ARGV[1] from the hash KEYS[1] and returns
+// it, or returns nil if there is none.
+const takeScript = `
+local entry = redis.call('HGET', KEYS[1], ARGV[1])
+if entry then
+	redis.call('HDEL', KEYS[1], ARGV[1])
+end
+return entry
+`
+
+// purgeScript deletes the hash KEYS[1], returning how many entries it held.
+const purgeScript = `
+local purged = redis.call('HLEN', KEYS[1])
+redis.call('DEL', KEYS[1])
+return purged
+`
+
+// Encoder serialises entries so that they can be stored in Redis.
+type Encoder interface {
+	Serialise(toSerialise Entry) ([]byte, error)
+	Deserialise(toDeserialise []byte) (Entry, error)
+}
+
+// RedisQueue is a Queue shared by every process connected to the same Redis, so
+// that tasks dead-lettered by any worker pool replica can be inspected and replayed
+// from the server. Entries are stored in a hash keyed by task ID.
+type RedisQueue struct {
+	client  redisClient
+	key     string
+	encoder Encoder
+}
+
+// NewRedisQueue creates a RedisQueue that keeps entries in a hash under the given
+// key.
+func NewRedisQueue(client redisClient, key string, encoder Encoder) *RedisQueue {
+	return &RedisQueue{client: client, key: key, encoder: encoder}
+}
+
+func (r *RedisQueue) Add(ctx context.Context, e Entry) error {
+	encoded, err := r.encoder.Serialise(e)
+	if err != nil {
+		return err
+	}
+
+	return r.client.HSet(ctx, r.key, e.Task.ID, encoded).Err()
+}
+
+func (r *RedisQueue) List(ctx context.Context) ([]Entry, error) {
+	encoded, err := r.client.HGetAll(ctx, r.key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(encoded))
+
+	for _, value := range encoded {
+		e, err := r.encoder.Deserialise([]byte(value))
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, e)
+	}
+
+	sortByFailedAt(entries)
+
+	return entries, nil
+}
+
+func (r *RedisQueue) Take(ctx context.Context, taskID string) (Entry, bool, error) {
+	encoded, err := r.client.Eval(ctx, takeScript, []string{r.key}, taskID).Text()
+	if errors.Is(err, redis.Nil) {
+		return Entry{}, false, nil
+	}
+
+	if err != nil {
+		return Entry{}, false, err
+	}
+
+	e, err := r.encoder.Deserialise([]byte(encoded))
+	if err != nil {
+		return Entry{}, false, err
+	}
+
+	return e, true, nil
+}
+
+func (r *RedisQueue) Purge(ctx context.Context) (int, error) {
+	return r.client.Eval(ctx, purgeScript, []string{r.key}).Int()
+}
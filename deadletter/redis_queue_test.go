@@ -0,0 +1,153 @@
+//go:build unit
+
+package deadletter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/pkg/serialise"
+	"github.com/jamesTait-jt/goflow/task"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_RedisQueue_Add(t *testing.T) {
+	t.Run("Stores the encoded entry under its task ID", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		encoder := serialise.NewGobSerialiser[Entry]()
+		q := NewRedisQueue(client, "deadletter", encoder)
+
+		ctx := context.Background()
+		entry := Entry{Task: task.Task{ID: "task-id", Type: "resize"}, Reason: "failed", Attempts: 3}
+		encoded, _ := encoder.Serialise(entry)
+
+		cmd := redis.NewIntCmd(ctx)
+		cmd.SetVal(1)
+		client.On("HSet", ctx, "deadletter", []any{"task-id", encoded}).Once().Return(cmd)
+
+		// Act
+		err := q.Add(ctx, entry)
+
+		// Assert
+		assert.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+}
+
+func Test_RedisQueue_List(t *testing.T) {
+	t.Run("Decodes every entry oldest first", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		encoder := serialise.NewGobSerialiser[Entry]()
+		q := NewRedisQueue(client, "deadletter", encoder)
+
+		ctx := context.Background()
+
+		now := time.Now().UTC()
+		newer := Entry{Task: task.Task{ID: "newer"}, FailedAt: now}
+		older := Entry{Task: task.Task{ID: "older"}, FailedAt: now.Add(-time.Minute)}
+		encodedNewer, _ := encoder.Serialise(newer)
+		encodedOlder, _ := encoder.Serialise(older)
+
+		cmd := redis.NewMapStringStringCmd(ctx)
+		cmd.SetVal(map[string]string{"newer": string(encodedNewer), "older": string(encodedOlder)})
+		client.On("HGetAll", ctx, "deadletter").Once().Return(cmd)
+
+		// Act
+		entries, err := q.List(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, entries, 2)
+		assert.Equal(t, "older", entries[0].Task.ID)
+		assert.Equal(t, "newer", entries[1].Task.ID)
+	})
+}
+
+func Test_RedisQueue_Take(t *testing.T) {
+	t.Run("Returns the removed entry", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		encoder := serialise.NewGobSerialiser[Entry]()
+		q := NewRedisQueue(client, "deadletter", encoder)
+
+		ctx := context.Background()
+		entry := Entry{Task: task.Task{ID: "task-id"}, Reason: "failed"}
+		encoded, _ := encoder.Serialise(entry)
+
+		cmd := redis.NewCmd(ctx)
+		cmd.SetVal(string(encoded))
+		client.On("Eval", ctx, takeScript, []string{"deadletter"}, []any{"task-id"}).Once().Return(cmd)
+
+		// Act
+		taken, ok, err := q.Take(ctx, "task-id")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, entry, taken)
+	})
+
+	t.Run("Reports a missing entry", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		q := NewRedisQueue(client, "deadletter", serialise.NewGobSerialiser[Entry]())
+
+		ctx := context.Background()
+
+		cmd := redis.NewCmd(ctx)
+		cmd.SetErr(redis.Nil)
+		client.On("Eval", ctx, takeScript, []string{"deadletter"}, []any{"task-id"}).Once().Return(cmd)
+
+		// Act
+		_, ok, err := q.Take(ctx, "task-id")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func Test_RedisQueue_Purge(t *testing.T) {
+	t.Run("Returns how many entries were purged", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		q := NewRedisQueue(client, "deadletter", serialise.NewGobSerialiser[Entry]())
+
+		ctx := context.Background()
+
+		cmd := redis.NewCmd(ctx)
+		cmd.SetVal(int64(3))
+		client.On("Eval", ctx, purgeScript, []string{"deadletter"}, []any(nil)).Once().Return(cmd)
+
+		// Act
+		purged, err := q.Purge(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 3, purged)
+	})
+}
+
+type mockRedisClient struct {
+	mock.Mock
+}
+
+func (m *mockRedisClient) HSet(ctx context.Context, key string, values ...any) *redis.IntCmd {
+	called := m.Called(ctx, key, values)
+	return called.Get(0).(*redis.IntCmd)
+}
+
+func (m *mockRedisClient) HGetAll(ctx context.Context, key string) *redis.MapStringStringCmd {
+	called := m.Called(ctx, key)
+	return called.Get(0).(*redis.MapStringStringCmd)
+}
+
+func (m *mockRedisClient) Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd {
+	called := m.Called(ctx, script, keys, args)
+	return called.Get(0).(*redis.Cmd)
+}
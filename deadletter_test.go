@@ -0,0 +1,122 @@
+//go:build unit
+
+package goflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/deadletter"
+	"github.com/jamesTait-jt/goflow/pkg/store"
+	"github.com/jamesTait-jt/goflow/task"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GoFlow_DeadLetters(t *testing.T) {
+	t.Run("Lists and replays a task that had no handler", func(t *testing.T) {
+		// Arrange
+		gf := NewLocalMode(store.NewInMemoryKVStore[string, task.Handler](), WithNumWorkers(1))
+
+		_ = gf.Start()
+		defer gf.Close()
+
+		metadata := map[string]string{"tenant": "acme"}
+		taskID, _ := gf.Push("late", "payload", WithPriority(3), WithMetadata(metadata))
+
+		var entries []deadletter.Entry
+
+		awaitCtx, cancelAwait := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancelAwait()
+
+		unhandled, unhandledErr := gf.Await(awaitCtx, taskID)
+
+		deadLettered := assert.Eventually(t, func() bool {
+			entries, _ = gf.ListDeadLetters()
+			return len(entries) == 1
+		}, 5*time.Second, time.Millisecond)
+		if !deadLettered {
+			return
+		}
+
+		gf.RegisterHandler("late", func(_ context.Context, t task.Task) task.Result {
+			return task.Result{Payload: t.Payload}
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// Act
+		replayedID, replayErr := gf.ReplayDeadLetter(taskID)
+		result, awaitErr := gf.Await(ctx, replayedID)
+		remaining, listErr := gf.ListDeadLetters()
+
+		// Assert
+		assert.NoError(t, unhandledErr)
+		assert.Equal(t, task.StateFailed, unhandled.State)
+		assert.Equal(t, `no handler registered for task type "late"`, unhandled.ErrMsg)
+
+		assert.Equal(t, taskID, entries[0].Task.ID)
+		assert.Equal(t, 3, entries[0].Task.Priority)
+		assert.Equal(t, `no handler registered for task type "late"`, entries[0].Reason)
+
+		assert.NoError(t, replayErr)
+		assert.NotEqual(t, taskID, replayedID)
+		assert.NoError(t, awaitErr)
+		assert.Equal(t, "payload", result.Payload)
+		assert.Equal(t, metadata, result.Metadata)
+		assert.NoError(t, listErr)
+		assert.Empty(t, remaining)
+	})
+
+	t.Run("Returns deadletter.ErrNotFound when replaying a task that is not dead-lettered", func(t *testing.T) {
+		// Arrange
+		gf := NewLocalMode(store.NewInMemoryKVStore[string, task.Handler]())
+
+		_ = gf.Start()
+		defer gf.Close()
+
+		// Act
+		_, err := gf.ReplayDeadLetter("unknown")
+
+		// Assert
+		assert.ErrorIs(t, err, deadletter.ErrNotFound)
+	})
+
+	t.Run("Purges every dead letter", func(t *testing.T) {
+		// Arrange
+		queue := deadletter.NewInMemoryQueue()
+		gf := NewLocalMode(store.NewInMemoryKVStore[string, task.Handler](), WithDeadLetterQueue(queue))
+
+		_ = gf.Start()
+		defer gf.Close()
+
+		ctx := context.Background()
+		_ = queue.Add(ctx, deadletter.Entry{Task: task.Task{ID: "a"}})
+		_ = queue.Add(ctx, deadletter.Entry{Task: task.Task{ID: "b"}})
+
+		// Act
+		purged, err := gf.PurgeDeadLetters()
+		entries, _ := gf.ListDeadLetters()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, purged)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("Returns ErrNotStarted if GoFlow instance isn't started", func(t *testing.T) {
+		// Arrange
+		gf := NewLocalMode(store.NewInMemoryKVStore[string, task.Handler]())
+
+		// Act
+		_, listErr := gf.ListDeadLetters()
+		_, replayErr := gf.ReplayDeadLetter("task-id")
+		_, purgeErr := gf.PurgeDeadLetters()
+
+		// Assert
+		assert.ErrorIs(t, listErr, ErrNotStarted)
+		assert.ErrorIs(t, replayErr, ErrNotStarted)
+		assert.ErrorIs(t, purgeErr, ErrNotStarted)
+	})
+}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/jamesTait-jt/goflow"
 	"github.com/jamesTait-jt/goflow/broker"
@@ -38,32 +37,18 @@ func main() {
 
 	maxItrs := 100
 
-	results := make(chan task.Result)
+	taskIDs := []string{}
 
 	for i := 0; i < maxItrs; i++ {
 		id, _ := gf.Push("testplugin", "Im a random sleeper")
-
-		go func() {
-			for {
-				result, ok, _ := gf.GetResult(id)
-				if !ok {
-					time.Sleep(time.Second)
-					continue
-				}
-
-				results <- result
-				if i == maxItrs-1 {
-					close(results)
-				}
-
-				return
-			}
-		}()
+		taskIDs = append(taskIDs, id)
 	}
 
 	i := 0
-	for r := range results {
+	for r := range gf.Subscribe(taskIDs...) {
 		i++
 		fmt.Println(i, r)
 	}
+
+	gf.Close()
 }
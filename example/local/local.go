@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -30,12 +31,17 @@ func main() {
 		goflow.WithResultsStore(resultsStore),
 	)
 
-	taskHandler := func(payload any) task.Result {
+	taskHandler := func(ctx context.Context, t task.Task) task.Result {
 		n, _ := rand.Int(rand.Reader, big.NewInt(int64(maxRandomSleepInMlliseconds)))
 		fmt.Printf("Sleeping %d milliseconds...\n", n.Int64())
-		time.Sleep(time.Millisecond * time.Duration(n.Int64()))
 
-		return task.Result{Payload: fmt.Sprintf("Processed: %v", payload)}
+		select {
+		case <-ctx.Done():
+			return task.Result{ErrMsg: ctx.Err().Error()}
+		case <-time.After(time.Millisecond * time.Duration(n.Int64())):
+		}
+
+		return task.Result{Payload: fmt.Sprintf("Processed: %v", t.Payload)}
 	}
 
 	taskType := "exampleTask"
@@ -56,10 +62,13 @@ func main() {
 		taskIDs = append(taskIDs, taskID)
 	}
 
-	time.Sleep(time.Second * 1)
-
 	for i := 0; i < len(taskIDs); i++ {
-		result, _, _ := gf.GetResult(taskIDs[i])
+		result, err := gf.Await(context.Background(), taskIDs[i])
+		if err != nil {
+			fmt.Printf("Error awaiting task: %v\n", err)
+			return
+		}
+
 		fmt.Println(result)
 	}
 
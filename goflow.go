@@ -3,10 +3,18 @@ package goflow
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"log"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/jamesTait-jt/goflow/broker"
+	"github.com/jamesTait-jt/goflow/deadletter"
+	"github.com/jamesTait-jt/goflow/idempotency"
+	"github.com/jamesTait-jt/goflow/pkg/store"
+	"github.com/jamesTait-jt/goflow/schedule"
 	"github.com/jamesTait-jt/goflow/task"
 	"github.com/jamesTait-jt/goflow/workerpool"
 )
@@ -24,12 +32,12 @@ type Broker[T task.TaskOrResult] interface {
 // mode, the worker pool is abstracted away from GoFlow by the task and results brokers.
 type WorkerPool interface {
 	// Start initializes the worker pool, with workers listening to taskQueue and
-	// submitting results. It should be non-blocking, starting workers in their own
-	// goroutines and returning immediately. The worker pool will run until the context
-	// is canceled.
+	// submitting results. Failed tasks may be resubmitted to taskQueue for retry.
+	// It should be non-blocking, starting workers in their own goroutines and
+	// returning immediately. The worker pool will run until the context is canceled.
 	Start(
 		ctx context.Context,
-		taskQueue task.Dequeuer[task.Task],
+		taskQueue workerpool.TaskQueue,
 		results task.Submitter[task.Result],
 		taskHandlers workerpool.HandlerGetter,
 	)
@@ -40,7 +48,7 @@ type WorkerPool interface {
 }
 
 // KVStore defines a key-value store interface in the GoFlow framework. It provides
-// methods for storing and retrieving values associated with keys.
+// methods for storing, retrieving and deleting values associated with keys.
 //
 // Users can implement KVStore to create custom key-value storage solutions as needed.
 // Example implementations could include in-memory, database-backed, or other forms
@@ -49,9 +57,33 @@ type KVStore[K comparable, V any] interface {
 	// Put stores the value associated with the given key.
 	Put(k K, v V)
 
+	// PutWithTTL stores the value associated with the given key until the TTL has
+	// passed, after which Get should no longer find it. A TTL of 0 or less means the
+	// value never expires.
+	PutWithTTL(k K, v V, ttl time.Duration)
+
 	// Get retrieves the value associated with the given key, returning
 	// the value and a boolean indicating whether the key was found.
 	Get(k K) (V, bool)
+
+	// Delete removes the value associated with the given key, if there is one.
+	Delete(k K)
+}
+
+// ScanKVStore is a KVStore whose entries can be listed a page at a time. It is an
+// optional extension: GoFlow checks whether its results store implements it with a
+// type assertion, and ListResults is only supported if it does. Every store in
+// pkg/store implements it.
+type ScanKVStore[V any] interface {
+	KVStore[string, V]
+
+	// Scan returns a page of the entries whose keys start with prefix and whose
+	// values match, where a nil match matches every value. The first page is
+	// returned for an empty cursor, and each later page for the cursor returned with
+	// the page before it. The cursor returned with the last page is empty. A limit
+	// of 0 or less returns every entry at once. Stores may return more than limit
+	// entries in a page, but only the last page may hold fewer.
+	Scan(prefix, cursor string, limit int, match func(V) bool) ([]store.Entry[V], string, error)
 }
 
 // GoFlow is the core structure of the framework. It manages interactions with brokers
@@ -67,13 +99,37 @@ type GoFlow struct {
 	taskHandlers    KVStore[string, task.Handler]
 	resultsBroker   Broker[task.Result]
 	results         KVStore[string, task.Result]
+	statuses        KVStore[string, task.Status]
+	groups          KVStore[string, Group]
+	statusMu        sync.Mutex
 	resultsWriterWG *sync.WaitGroup
+	subscriptions   subscriptions
+	subscribersWG   sync.WaitGroup
+	hooks           hooks
+	hooksWG         sync.WaitGroup
+	scheduler       *schedule.Scheduler
+	idempotency     idempotency.Store
+	deadLetters     deadletter.Queue
 	started         bool
+	startedMu       sync.RWMutex
+
+	defaultTaskTimeout time.Duration
+	resultPollInterval time.Duration
+	idempotencyWindow  time.Duration
+	resultTTL          time.Duration
 }
 
 var (
-	ErrAlreadyStarted = errors.New("GoFlow is already started")
-	ErrNotStarted     = errors.New("GoFlow is not started yet")
+	ErrAlreadyStarted         = errors.New("GoFlow is already started")
+	ErrNotStarted             = errors.New("GoFlow is not started yet")
+	ErrDelayedPushUnsupported = errors.New("task broker does not support delayed submission")
+	ErrInvalidPriority        = fmt.Errorf("priority must be between 0 and %d", task.MaxPriority)
+	ErrClosed                 = errors.New("GoFlow was closed")
+	ErrCancelUnsupported      = errors.New("task broker does not support cancellation")
+	ErrTaskNotFound           = errors.New("task not found")
+	ErrTaskFinished           = errors.New("task has already finished")
+	ErrTaskFailed             = errors.New("task failed")
+	ErrListUnsupported        = errors.New("results store does not support listing")
 )
 
 // New creates and initializes a new GoFlow instance in distributed mode.
@@ -96,9 +152,21 @@ func New(taskBroker Broker[task.Task], resultsBroker Broker[task.Result], opts .
 		taskBroker:      taskBroker,
 		resultsBroker:   resultsBroker,
 		results:         options.resultsStore,
+		statuses:        options.statusStore,
+		groups:          options.groupStore,
 		resultsWriterWG: &sync.WaitGroup{},
+
+		defaultTaskTimeout: options.defaultTaskTimeout,
+		resultPollInterval: options.resultPollInterval,
+		hooks:              options.hooks,
+		idempotency:        options.idempotencyStore,
+		idempotencyWindow:  options.idempotencyWindow,
+		deadLetters:        options.deadLetterQueue,
+		resultTTL:          options.resultTTL,
 	}
 
+	gf.scheduler = schedule.NewScheduler(options.scheduleStore, scheduledTaskSubmitter{gf: &gf})
+
 	return &gf
 }
 
@@ -118,19 +186,41 @@ func NewLocalMode(
 		o.apply(&options)
 	}
 
+	workers := workerpool.New(
+		options.numWorkers,
+		workerpool.WithRetryPolicies(options.retryPolicies),
+		workerpool.WithRateLimits(options.rateLimits),
+		workerpool.WithConcurrencyLimits(options.concurrencyLimits),
+		workerpool.WithMiddleware(options.middleware...),
+		workerpool.WithPanicQuarantine(options.panicThreshold, options.quarantinePeriod),
+		workerpool.WithDeadLetterQueue(options.deadLetterQueue),
+	)
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 	gf := GoFlow{
 		ctx:             ctx,
 		cancel:          cancel,
-		workers:         workerpool.New(options.numWorkers),
+		workers:         workers,
 		taskBroker:      broker.NewChannelBroker[task.Task](options.taskQueueBufferSize),
 		taskHandlers:    taskHandlers,
 		resultsBroker:   broker.NewChannelBroker[task.Result](options.resultQueueBufferSize),
 		results:         options.resultsStore,
+		statuses:        options.statusStore,
+		groups:          options.groupStore,
 		resultsWriterWG: &sync.WaitGroup{},
+
+		defaultTaskTimeout: options.defaultTaskTimeout,
+		resultPollInterval: options.resultPollInterval,
+		hooks:              options.hooks,
+		idempotency:        options.idempotencyStore,
+		idempotencyWindow:  options.idempotencyWindow,
+		deadLetters:        options.deadLetterQueue,
+		resultTTL:          options.resultTTL,
 	}
 
+	gf.scheduler = schedule.NewScheduler(options.scheduleStore, scheduledTaskSubmitter{gf: &gf})
+
 	return &gf
 }
 
@@ -140,8 +230,11 @@ func NewLocalMode(
 // necessary in distributed mode.
 //
 // Additionally, the method launches a goroutine to persist results from the results
-// broker to the results store.
+// broker to the results store, and starts the scheduler for recurring tasks.
 func (gf *GoFlow) Start() error {
+	gf.startedMu.Lock()
+	defer gf.startedMu.Unlock()
+
 	if gf.started {
 		return ErrAlreadyStarted
 	}
@@ -156,24 +249,41 @@ func (gf *GoFlow) Start() error {
 	gf.resultsWriterWG.Add(1)
 	go gf.persistResults(gf.resultsBroker, gf.resultsWriterWG)
 
+	if gf.scheduler != nil {
+		gf.scheduler.Start(gf.ctx)
+	}
+
 	return nil
 }
 
 // Close gracefully shuts down the GoFlow instance. It cancels the context to signal
 // all ongoing operations to stop. If the worker pool is configured, (i.e. local mode)
 // it waits for all workers to complete their tasks and shut down before returning.
+// It also waits for any hooks and callbacks that are still running to return.
 func (gf *GoFlow) Close() error {
+	gf.startedMu.Lock()
+
 	if !gf.started {
+		gf.startedMu.Unlock()
+
 		return ErrNotStarted
 	}
 
 	gf.started = false
+	gf.startedMu.Unlock()
 
 	gf.cancel()
 
 	gf.resultsWriterWG.Wait()
-	gf.resultsBroker.AwaitShutdown()
-	gf.taskBroker.AwaitShutdown()
+	gf.subscribersWG.Wait()
+	gf.hooksWG.Wait()
+
+	if gf.scheduler != nil {
+		gf.scheduler.AwaitShutdown()
+	}
+
+	shutdownBroker(gf.resultsBroker)
+	shutdownBroker(gf.taskBroker)
 
 	if gf.workers != nil {
 		gf.workers.AwaitShutdown()
@@ -182,6 +292,29 @@ func (gf *GoFlow) Close() error {
 	return nil
 }
 
+// shutdownBroker closes the broker if it holds resources, such as the timers of
+// scheduled tasks, until it is closed, and waits for it to shut down.
+func shutdownBroker[T task.TaskOrResult](b Broker[T]) {
+	closer, ok := b.(io.Closer)
+	if !ok {
+		b.AwaitShutdown()
+		return
+	}
+
+	if err := closer.Close(); err != nil {
+		log.Printf("failed to close broker: %v", err)
+	}
+}
+
+// isStarted reports whether the instance is started. Close can be called while other
+// goroutines, such as group watchers and workflow callbacks, are pushing tasks.
+func (gf *GoFlow) isStarted() bool {
+	gf.startedMu.RLock()
+	defer gf.startedMu.RUnlock()
+
+	return gf.started
+}
+
 // RegisterHandler registers a task handler for the specified task type. It stores
 // the handler in the taskHandlers store for local mode execution. Handlers can be
 // dynamically registered while the goflow instance is running.
@@ -203,29 +336,207 @@ func (gf *GoFlow) RegisterHandler(taskType string, handler task.Handler) {
 // It creates a task, submits it to the broker, and returns the task's ID.
 //
 // The task is processed by the worker pool, and the caller can use the returned
-// task ID to retrieve the result later.
-func (gf *GoFlow) Push(taskType string, payload any) (string, error) {
-	if !gf.started {
+// task ID to retrieve the result later. PushOptions such as WithTaskTimeout can be
+// used to configure the individual task. If the push has an idempotency key (see
+// WithIdempotencyKey) that has already been used, the ID of the original task is
+// returned instead.
+func (gf *GoFlow) Push(taskType string, payload any, opts ...PushOption) (string, error) {
+	if !gf.isStarted() {
 		return "", ErrNotStarted
 	}
 
-	t := task.New(taskType, payload)
+	t, pushOpts, err := gf.newTask(taskType, payload, opts)
+	if err != nil {
+		return "", err
+	}
+
+	taskID, claimed, err := gf.claimIdempotencyKey(t, pushOpts)
+	if err != nil || !claimed {
+		return taskID, err
+	}
 
-	err := gf.taskBroker.Submit(gf.ctx, t)
+	err = gf.submit(t, pushOpts.runAt)
 	if err != nil {
+		gf.releaseIdempotencyKey(t, pushOpts)
+
 		return "", err
 	}
 
+	gf.pushed(t, pushOpts)
+
 	return t.ID, nil
 }
 
+// PushRequest describes a single task pushed with PushBatch.
+type PushRequest struct {
+	TaskType string
+	Payload  any
+	Opts     []PushOption
+}
+
+// PushBatch submits many tasks at once and returns their IDs, in the same order as
+// the requests. If the task broker implements task.BatchSubmitter, as both brokers
+// provided by GoFlow do, the tasks that are not delayed are submitted in a single
+// round trip. Requests with an idempotency key that has already been used, including
+// by an earlier request in the batch, are not pushed and get the original task's ID.
+//
+// Every request is validated before anything is submitted. A batch submitted in one
+// round trip is submitted atomically, so if it fails nothing was pushed. If
+// submitting fails after some tasks were pushed, such as a delayed task, or a task
+// sent to a broker without batch support, the error is a *PartialPushError holding
+// the IDs of the tasks that were pushed, whose statuses are recorded, and the
+// idempotency keys of the rest are released so that they can be pushed again.
+func (gf *GoFlow) PushBatch(requests []PushRequest) ([]string, error) {
+	if !gf.isStarted() {
+		return nil, ErrNotStarted
+	}
+
+	tasks := make([]task.Task, 0, len(requests))
+	opts := make([]pushOptions, 0, len(requests))
+
+	for _, req := range requests {
+		t, pushOpts, err := gf.newTask(req.TaskType, req.Payload, req.Opts)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, t)
+		opts = append(opts, pushOpts)
+	}
+
+	ids := make([]string, len(tasks))
+	toPush := make([]int, 0, len(tasks))
+
+	release := func() {
+		for _, i := range toPush {
+			gf.releaseIdempotencyKey(tasks[i], opts[i])
+		}
+	}
+
+	for i, t := range tasks {
+		taskID, claimed, err := gf.claimIdempotencyKey(t, opts[i])
+		if err != nil {
+			release()
+
+			return nil, err
+		}
+
+		ids[i] = taskID
+
+		if claimed {
+			toPush = append(toPush, i)
+		}
+	}
+
+	var immediate, delayed []int
+
+	for _, i := range toPush {
+		if opts[i].runAt.IsZero() {
+			immediate = append(immediate, i)
+		} else {
+			delayed = append(delayed, i)
+		}
+	}
+
+	submitted, err := gf.submitBatch(tasks, immediate)
+	if err != nil {
+		if submitted == 0 {
+			release()
+
+			return nil, err
+		}
+
+		return nil, gf.partialPush(tasks, opts, ids, immediate[:submitted], slices.Concat(immediate[submitted:], delayed), err)
+	}
+
+	// Delayed tasks are held back by the broker one at a time
+	for n, i := range delayed {
+		err = gf.submit(tasks[i], opts[i].runAt)
+		if err != nil {
+			return nil, gf.partialPush(tasks, opts, ids, slices.Concat(immediate, delayed[:n]), delayed[n:], err)
+		}
+	}
+
+	for _, i := range toPush {
+		gf.pushed(tasks[i], opts[i])
+	}
+
+	return ids, nil
+}
+
+// PartialPushError is returned by PushBatch if it fails after some of the tasks were
+// pushed. IDs holds the ID of each request in order, as PushBatch would have
+// returned, with "" for the tasks that were not pushed.
+type PartialPushError struct {
+	IDs []string
+	Err error
+}
+
+func (e *PartialPushError) Error() string {
+	pushed := 0
+
+	for _, id := range e.IDs {
+		if id != "" {
+			pushed++
+		}
+	}
+
+	return fmt.Sprintf("pushed %d of %d tasks before failing: %v", pushed, len(e.IDs), e.Err)
+}
+
+func (e *PartialPushError) Unwrap() error {
+	return e.Err
+}
+
+// partialPush finishes a batch that failed to submit part way. The tasks that were
+// submitted are recorded as pushed, and the rest have their idempotency keys
+// released and are left out of the returned error's IDs.
+func (gf *GoFlow) partialPush(
+	tasks []task.Task,
+	opts []pushOptions,
+	ids []string,
+	submitted, unsubmitted []int,
+	err error,
+) error {
+	for _, i := range submitted {
+		gf.pushed(tasks[i], opts[i])
+	}
+
+	for _, i := range unsubmitted {
+		gf.releaseIdempotencyKey(tasks[i], opts[i])
+
+		ids[i] = ""
+	}
+
+	return &PartialPushError{IDs: ids, Err: err}
+}
+
+// PushAt submits a new task that will not be processed before the given time. The
+// task broker holds the task until it is due, so the broker must implement
+// task.DelayedSubmitter. Both brokers provided by GoFlow do.
+func (gf *GoFlow) PushAt(taskType string, payload any, at time.Time, opts ...PushOption) (string, error) {
+	return gf.Push(taskType, payload, append(opts, WithRunAt(at))...)
+}
+
+// PushAfter submits a new task that will not be processed until the given delay
+// has passed. See PushAt.
+func (gf *GoFlow) PushAfter(taskType string, payload any, delay time.Duration, opts ...PushOption) (string, error) {
+	return gf.Push(taskType, payload, append(opts, WithDelay(delay))...)
+}
+
+// PushWithPriority submits a new task that is dequeued ahead of any waiting tasks
+// with a lower priority. Priorities range from 0, the default, to task.MaxPriority.
+func (gf *GoFlow) PushWithPriority(taskType string, payload any, priority int, opts ...PushOption) (string, error) {
+	return gf.Push(taskType, payload, append(opts, WithPriority(priority))...)
+}
+
 // GetResult retrieves the result associated with the specified task ID. It returns
 // the result and a boolean indicating whether the result was found.
 //
 // If the task with the given ID has completed, the result will be returned. If the
 // task has not yet completed or does not exist, the boolean will be false.
 func (gf *GoFlow) GetResult(taskID string) (task.Result, bool, error) {
-	if !gf.started {
+	if !gf.isStarted() {
 		return task.Result{}, false, ErrNotStarted
 	}
 
@@ -234,6 +545,304 @@ func (gf *GoFlow) GetResult(taskID string) (task.Result, bool, error) {
 	return result, ok, nil
 }
 
+// Await blocks until the result of the task with the specified ID is available and
+// returns it. It returns ctx.Err() if ctx is done first, and ErrClosed if GoFlow is
+// closed while waiting.
+//
+// Await does not check that the task exists, so awaiting a task that was never
+// pushed blocks until ctx is done.
+func (gf *GoFlow) Await(ctx context.Context, taskID string) (task.Result, error) {
+	if !gf.isStarted() {
+		return task.Result{}, ErrNotStarted
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	result, ok := <-gf.SubscribeContext(ctx, taskID)
+	if ok {
+		return result, nil
+	}
+
+	if ctx.Err() != nil {
+		return task.Result{}, ctx.Err()
+	}
+
+	return task.Result{}, ErrClosed
+}
+
+// Subscribe returns a channel that receives the result of each of the specified
+// tasks as it becomes available, in the order they complete. The channel is closed
+// once every result has been delivered, or when GoFlow is closed.
+//
+// Results are delivered as soon as this instance persists them. Results persisted
+// by another GoFlow instance sharing the results store are picked up by polling the
+// store (see WithResultPollInterval). If GoFlow is not started, the returned channel
+// is already closed.
+func (gf *GoFlow) Subscribe(taskIDs ...string) <-chan task.Result {
+	return gf.SubscribeContext(context.Background(), taskIDs...)
+}
+
+// SubscribeContext is like Subscribe, but also closes the channel when ctx is done.
+func (gf *GoFlow) SubscribeContext(ctx context.Context, taskIDs ...string) <-chan task.Result {
+	// Buffered so that delivering results never waits on the caller
+	out := make(chan task.Result, len(taskIDs))
+
+	if !gf.isStarted() {
+		close(out)
+
+		return out
+	}
+
+	gf.subscribersWG.Add(1)
+
+	notify := make(chan task.Result, len(taskIDs))
+	gf.subscriptions.add(taskIDs, notify)
+
+	go gf.watchResults(ctx, taskIDs, notify, out)
+
+	return out
+}
+
+// AddSchedule registers a recurring task. Each time spec fires, a task with the given
+// type and payload is pushed. spec is either a five field cron expression, evaluated
+// in UTC, or an interval such as "@every 5m" (see schedule.Parse). The returned
+// schedule ID can be used to remove the schedule.
+func (gf *GoFlow) AddSchedule(taskType string, payload any, spec string) (string, error) {
+	if !gf.isStarted() {
+		return "", ErrNotStarted
+	}
+
+	s, err := gf.scheduler.Add(gf.ctx, taskType, payload, spec)
+	if err != nil {
+		return "", err
+	}
+
+	return s.ID, nil
+}
+
+// RemoveSchedule stops the schedule with the given ID from firing. It returns
+// schedule.ErrNotFound if there is no such schedule.
+func (gf *GoFlow) RemoveSchedule(scheduleID string) error {
+	if !gf.isStarted() {
+		return ErrNotStarted
+	}
+
+	return gf.scheduler.Remove(gf.ctx, scheduleID)
+}
+
+// ListSchedules returns every registered schedule along with its next run time.
+func (gf *GoFlow) ListSchedules() ([]schedule.Schedule, error) {
+	if !gf.isStarted() {
+		return nil, ErrNotStarted
+	}
+
+	return gf.scheduler.List(gf.ctx)
+}
+
+// GetStatus retrieves the status of the task with the specified ID. It returns the
+// status and a boolean indicating whether the task was found.
+//
+// Tasks are pending once pushed, running while a worker is processing them, and
+// then succeeded, failed or cancelled. A task that is not found was either never
+// pushed or pushed to a different GoFlow instance that does not share its status
+// store. Instances that share a results store should share a status store too (see
+// WithStatusStore), as the GoFlow server does when it keeps results in Redis.
+func (gf *GoFlow) GetStatus(taskID string) (task.Status, bool, error) {
+	if !gf.isStarted() {
+		return task.Status{}, false, ErrNotStarted
+	}
+
+	status, ok := gf.statuses.Get(taskID)
+	if ok && status.State.Terminal() {
+		return status, true, nil
+	}
+
+	// The result records the final status. Check it even if a status was found, as
+	// instances sharing a status store can record updates out of order
+	result, resultOK := gf.results.Get(taskID)
+	if resultOK {
+		return result.Status(), true, nil
+	}
+
+	return status, ok, nil
+}
+
+// Cancel cancels the task with the specified ID. The cancellation is recorded by the
+// task broker, which must implement task.Canceller. Both brokers provided by GoFlow
+// do, and the Redis broker shares cancellations with every worker consuming its
+// queue.
+//
+// A task that has not started yet is skipped by the worker that dequeues it, and
+// its cancelled result is written straight away. A running task has its context
+// cancelled, and the worker writes its cancelled result once it notices. Cancelled
+// results have the state task.StateCancelled.
+//
+// Cancel returns ErrTaskNotFound if the task's status is unknown (see GetStatus),
+// and ErrTaskFinished if it has already finished. A task that finishes while it is
+// being cancelled keeps whichever result arrives first.
+func (gf *GoFlow) Cancel(taskID string) error {
+	if !gf.isStarted() {
+		return ErrNotStarted
+	}
+
+	canceller, ok := gf.taskBroker.(task.Canceller)
+	if !ok {
+		return ErrCancelUnsupported
+	}
+
+	status, found, err := gf.GetStatus(taskID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return ErrTaskNotFound
+	}
+
+	if status.State.Terminal() {
+		return ErrTaskFinished
+	}
+
+	err = canceller.Cancel(gf.ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if status.State == task.StateRunning {
+		return nil
+	}
+
+	return gf.resultsBroker.Submit(gf.ctx, task.Result{
+		TaskID:     taskID,
+		TaskType:   status.TaskType,
+		ErrMsg:     task.CancelledErrMsg,
+		State:      task.StateCancelled,
+		Attempt:    status.Attempt,
+		EnqueuedAt: status.EnqueuedAt,
+		FinishedAt: time.Now(),
+		Metadata:   status.Metadata,
+	})
+}
+
+// newTask creates a task of the given type and payload, configured by the push
+// options.
+func (gf *GoFlow) newTask(taskType string, payload any, opts []PushOption) (task.Task, pushOptions, error) {
+	pushOpts := pushOptions{timeout: gf.defaultTaskTimeout}
+
+	for _, o := range opts {
+		o.apply(&pushOpts)
+	}
+
+	if pushOpts.priority < 0 || pushOpts.priority > task.MaxPriority {
+		return task.Task{}, pushOptions{}, ErrInvalidPriority
+	}
+
+	t := task.New(taskType, payload)
+	t.Timeout = pushOpts.timeout
+	t.Priority = pushOpts.priority
+	t.Metadata = pushOpts.metadata
+
+	return t, pushOpts, nil
+}
+
+// pushed records that the task has been submitted.
+func (gf *GoFlow) pushed(t task.Task, pushOpts pushOptions) {
+	gf.updateStatus(task.Status{
+		TaskID:     t.ID,
+		TaskType:   t.Type,
+		State:      task.StatePending,
+		Attempt:    1,
+		EnqueuedAt: t.EnqueuedAt,
+		Metadata:   t.Metadata,
+	})
+
+	if pushOpts.callback != nil {
+		gf.watchCallback(t.ID, pushOpts.callback)
+	}
+}
+
+// claimIdempotencyKey claims the push's idempotency key for the task. It returns the
+// ID of the task to report to the caller, and whether the task should be pushed,
+// which it always should if there is no key. If the key was already claimed, any
+// callback is attached to the original task instead.
+func (gf *GoFlow) claimIdempotencyKey(t task.Task, pushOpts pushOptions) (string, bool, error) {
+	if pushOpts.idempotencyKey == "" {
+		return t.ID, true, nil
+	}
+
+	taskID, claimed, err := gf.idempotency.Claim(gf.ctx, pushOpts.idempotencyKey, t.ID, gf.idempotencyWindow)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+
+	if !claimed && pushOpts.callback != nil {
+		gf.watchCallback(taskID, pushOpts.callback)
+	}
+
+	return taskID, claimed, nil
+}
+
+// releaseIdempotencyKey frees the push's idempotency key after the task could not be
+// submitted, so that the push can be retried.
+func (gf *GoFlow) releaseIdempotencyKey(t task.Task, pushOpts pushOptions) {
+	if pushOpts.idempotencyKey == "" {
+		return
+	}
+
+	err := gf.idempotency.Release(gf.ctx, pushOpts.idempotencyKey, t.ID)
+	if err != nil {
+		log.Printf("failed to release idempotency key %s: %v", pushOpts.idempotencyKey, err)
+	}
+}
+
+// submitBatch places the tasks at the given indices on the task broker, in a single
+// batch if the broker supports it, and returns how many were submitted. A batch is
+// submitted atomically, so all or none of it is; otherwise the tasks are submitted
+// one at a time until one fails.
+func (gf *GoFlow) submitBatch(tasks []task.Task, indices []int) (int, error) {
+	if len(indices) == 0 {
+		return 0, nil
+	}
+
+	if batcher, ok := gf.taskBroker.(task.BatchSubmitter[task.Task]); ok {
+		batch := make([]task.Task, 0, len(indices))
+		for _, i := range indices {
+			batch = append(batch, tasks[i])
+		}
+
+		if err := batcher.SubmitBatch(gf.ctx, batch); err != nil {
+			return 0, err
+		}
+
+		return len(batch), nil
+	}
+
+	for n, i := range indices {
+		err := gf.taskBroker.Submit(gf.ctx, tasks[i])
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return len(indices), nil
+}
+
+// submit places the task on the task broker, holding it back until runAt if it is
+// set.
+func (gf *GoFlow) submit(t task.Task, runAt time.Time) error {
+	if runAt.IsZero() {
+		return gf.taskBroker.Submit(gf.ctx, t)
+	}
+
+	delayed, ok := gf.taskBroker.(task.DelayedSubmitter[task.Task])
+	if !ok {
+		return ErrDelayedPushUnsupported
+	}
+
+	return delayed.SubmitAt(gf.ctx, t, runAt)
+}
+
 func (gf *GoFlow) persistResults(results task.Dequeuer[task.Result], wg *sync.WaitGroup) {
 	defer wg.Done()
 
@@ -243,7 +852,131 @@ func (gf *GoFlow) persistResults(results task.Dequeuer[task.Result], wg *sync.Wa
 			return
 
 		case result := <-results.Dequeue(gf.ctx):
-			gf.results.Put(result.TaskID, result)
+			status := result.Status()
+
+			// Once a task is cancelled, results from a worker that was still running
+			// it are dropped, as is a cancellation that arrives after it finished
+			if !gf.updateStatus(status) && status.State.Terminal() {
+				continue
+			}
+
+			// Results that only report a change of state have no outcome to store
+			if status.State.Terminal() {
+				gf.storeResult(result)
+				gf.subscriptions.publish(result)
+			}
+
+			gf.fireHooks(result)
 		}
 	}
 }
+
+func (gf *GoFlow) storeResult(result task.Result) {
+	putWithResultTTL(gf, gf.results, result.TaskID, result)
+}
+
+// putWithResultTTL puts the value in the store so that it expires with the result
+// TTL, if one is set. Statuses and groups are kept for as long as results, so that
+// they cannot outgrow the results store.
+func putWithResultTTL[V any](gf *GoFlow, kv KVStore[string, V], key string, value V) {
+	if gf.resultTTL > 0 {
+		kv.PutWithTTL(key, value, gf.resultTTL)
+		return
+	}
+
+	kv.Put(key, value)
+}
+
+// watchResults forwards the results of the given tasks to out as they are published
+// to notify or found in the results store, closing out once all have been sent.
+func (gf *GoFlow) watchResults(ctx context.Context, taskIDs []string, notify chan task.Result, out chan<- task.Result) {
+	defer gf.subscribersWG.Done()
+	defer close(out)
+	defer gf.subscriptions.remove(taskIDs, notify)
+
+	pending := make(map[string]struct{}, len(taskIDs))
+	for _, id := range taskIDs {
+		pending[id] = struct{}{}
+	}
+
+	deliver := func(result task.Result) {
+		if _, ok := pending[result.TaskID]; !ok {
+			return
+		}
+
+		delete(pending, result.TaskID)
+
+		out <- result
+	}
+
+	// Catches results persisted before subscribing, by another GoFlow instance, or
+	// whose notification was dropped
+	poll := func() {
+		for id := range pending {
+			if result, ok := gf.results.Get(id); ok {
+				deliver(result)
+			}
+		}
+	}
+
+	interval := gf.resultPollInterval
+	if interval <= 0 {
+		interval = defaultResultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	poll()
+
+	for len(pending) > 0 {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-gf.ctx.Done():
+			return
+
+		case result := <-notify:
+			deliver(result)
+
+		case <-ticker.C:
+			poll()
+		}
+	}
+}
+
+// scheduledTaskSubmitter submits tasks fired by the scheduler to the task broker,
+// applying the same defaults and recording the same status as Push.
+type scheduledTaskSubmitter struct {
+	gf *GoFlow
+}
+
+func (s scheduledTaskSubmitter) Submit(ctx context.Context, t task.Task) error {
+	pushOpts := pushOptions{timeout: s.gf.defaultTaskTimeout}
+	t.Timeout = pushOpts.timeout
+
+	if err := s.gf.taskBroker.Submit(ctx, t); err != nil {
+		return err
+	}
+
+	s.gf.pushed(t, pushOpts)
+
+	return nil
+}
+
+// updateStatus records the status unless a later status of the task has already
+// been recorded, reporting whether it was recorded.
+func (gf *GoFlow) updateStatus(status task.Status) bool {
+	gf.statusMu.Lock()
+	defer gf.statusMu.Unlock()
+
+	prev, ok := gf.statuses.Get(status.TaskID)
+	if ok && !status.Supersedes(prev) {
+		return false
+	}
+
+	putWithResultTTL(gf, gf.statuses, status.TaskID, status)
+
+	return true
+}
@@ -5,12 +5,18 @@ package goflow
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/jamesTait-jt/goflow/broker"
+	"github.com/jamesTait-jt/goflow/idempotency"
 	"github.com/jamesTait-jt/goflow/pkg/channel"
 	"github.com/jamesTait-jt/goflow/pkg/store"
+	"github.com/jamesTait-jt/goflow/ratelimit"
+	"github.com/jamesTait-jt/goflow/schedule"
 	"github.com/jamesTait-jt/goflow/task"
 	"github.com/jamesTait-jt/goflow/workerpool"
 	"github.com/stretchr/testify/assert"
@@ -31,6 +37,7 @@ func Test_New(t *testing.T) {
 		assert.NotNil(t, gf.ctx)
 		assert.NotNil(t, gf.cancel)
 		assert.NotNil(t, gf.resultsWriterWG)
+		assert.NotNil(t, gf.scheduler)
 
 		assert.Nil(t, gf.workers)
 		assert.Nil(t, gf.taskHandlers)
@@ -52,10 +59,21 @@ func Test_New(t *testing.T) {
 			nil,
 			nil,
 			WithResultsStore(resultStore),
+			WithDefaultTaskTimeout(time.Minute),
+			WithResultPollInterval(time.Millisecond),
+			WithOnResult(func(task.Result) {}),
+			WithOnResult(func(task.Result) {}),
+			WithOnFailure(func(task.Result) {}),
+			WithOnTaskStarted(func(task.Status) {}),
 		)
 
 		// Assert
 		assert.Equal(t, resultStore, gf.results)
+		assert.Equal(t, time.Minute, gf.defaultTaskTimeout)
+		assert.Equal(t, time.Millisecond, gf.resultPollInterval)
+		assert.Len(t, gf.hooks.onResult, 2)
+		assert.Len(t, gf.hooks.onFailure, 1)
+		assert.Len(t, gf.hooks.onTaskStarted, 1)
 	})
 }
 
@@ -72,6 +90,7 @@ func Test_NewLocalMode(t *testing.T) {
 		assert.NotNil(t, gf.ctx)
 		assert.NotNil(t, gf.cancel)
 		assert.NotNil(t, gf.resultsWriterWG)
+		assert.NotNil(t, gf.scheduler)
 
 		assert.IsType(t, &workerpool.Pool{}, gf.workers)
 		assert.IsType(t, &broker.ChannelBroker[task.Task]{}, gf.taskBroker)
@@ -102,6 +121,24 @@ func Test_NewLocalMode(t *testing.T) {
 		assert.IsType(t, &broker.ChannelBroker[task.Result]{}, gf.resultsBroker)
 		assert.Equal(t, resultStore, gf.results)
 	})
+
+	t.Run("Panics if a rate limit's rate is not positive", func(t *testing.T) {
+		for _, rate := range []float64{0, -1, math.NaN()} {
+			// Act & Assert
+			assert.Panics(t, func() {
+				WithRateLimit("exampleTask", ratelimit.Limit{Rate: rate, Burst: 1})
+			})
+		}
+	})
+
+	t.Run("Panics if the idempotency window is not positive", func(t *testing.T) {
+		for _, window := range []time.Duration{0, -time.Minute} {
+			// Act & Assert
+			assert.Panics(t, func() {
+				WithIdempotencyWindow(window)
+			})
+		}
+	})
 }
 
 func Test_GoFlow_Start(t *testing.T) {
@@ -132,6 +169,7 @@ func Test_GoFlow_Start(t *testing.T) {
 			resultsBroker:   broker.NewChannelBroker[task.Result](0),
 			resultsWriterWG: resultsWriterWG,
 			started:         false,
+			statuses:        store.NewInMemoryKVStore[string, task.Status](),
 		}
 
 		// Act
@@ -160,6 +198,7 @@ func Test_GoFlow_Start(t *testing.T) {
 			resultsBroker:   broker.NewChannelBroker[task.Result](0),
 			resultsWriterWG: resultsWriterWG,
 			started:         false,
+			statuses:        store.NewInMemoryKVStore[string, task.Status](),
 		}
 
 		// Act
@@ -194,6 +233,7 @@ func Test_GoFlow_Start(t *testing.T) {
 			taskHandlers:    taskHandlers,
 			resultsWriterWG: resultsWriterWG,
 			started:         false,
+			statuses:        store.NewInMemoryKVStore[string, task.Status](),
 		}
 
 		mockWorkers.On("Start", ctx, taskBroker, resultBroker, taskHandlers).Once()
@@ -219,13 +259,52 @@ func Test_GoFlow_Start(t *testing.T) {
 		resultStore.AssertExpectations(t)
 		assert.True(t, gf.started)
 	})
+
+	t.Run("Persists incoming results with the result TTL", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		resultsWriterWG := &sync.WaitGroup{}
+
+		taskBroker := new(mockBroker[task.Task])
+		resultBroker := new(mockBroker[task.Result])
+		resultStore := new(mockKVStore[string, task.Result])
+
+		gf := &GoFlow{
+			ctx:             ctx,
+			cancel:          cancel,
+			taskBroker:      taskBroker,
+			resultsBroker:   resultBroker,
+			results:         resultStore,
+			resultsWriterWG: resultsWriterWG,
+			statuses:        store.NewInMemoryKVStore[string, task.Status](),
+			resultTTL:       time.Hour,
+		}
+
+		returnCh := make(chan task.Result)
+		expectedResult := task.Result{TaskID: "1234"}
+
+		resultBroker.On("Dequeue", ctx).Twice().Return(channel.NewReadOnly(returnCh))
+
+		resultStore.On("PutWithTTL", expectedResult.TaskID, expectedResult, time.Hour).Once()
+
+		// Act
+		err := gf.Start()
+		returnCh <- expectedResult
+
+		cancel()
+
+		// Assert
+		resultsWriterWG.Wait()
+		assert.Nil(t, err)
+		resultStore.AssertExpectations(t)
+	})
 }
 
 func Test_GoFlow_RegisterHandler(t *testing.T) {
 	t.Run("Puts the handler in the handler store if in local mode", func(t *testing.T) {
 		// Arrange
 		mockHandlers := new(mockKVStore[string, task.Handler])
-		handler := func(_ any) task.Result {
+		handler := func(_ context.Context, _ task.Task) task.Result {
 			return task.Result{}
 		}
 		gf := GoFlow{
@@ -269,6 +348,7 @@ func Test_GoFlow_Push(t *testing.T) {
 			ctx:        ctx,
 			taskBroker: mockBroker,
 			started:    true,
+			statuses:   store.NewInMemoryKVStore[string, task.Status](),
 		}
 
 		var submittedTask task.Task
@@ -292,6 +372,48 @@ func Test_GoFlow_Push(t *testing.T) {
 		mockBroker.AssertExpectations(t)
 	})
 
+	t.Run("Sets the task timeout from the push options or the default", func(t *testing.T) {
+		type timeoutTest struct {
+			name           string
+			defaultTimeout time.Duration
+			opts           []PushOption
+			wantTimeout    time.Duration
+		}
+
+		for _, tt := range []timeoutTest{
+			{"no timeout", 0, nil, 0},
+			{"default timeout", time.Minute, nil, time.Minute},
+			{"push timeout", 0, []PushOption{WithTaskTimeout(time.Second)}, time.Second},
+			{"push timeout overrides default", time.Minute, []PushOption{WithTaskTimeout(time.Second)}, time.Second},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				// Arrange
+				mockBroker := new(mockBroker[task.Task])
+
+				gf := GoFlow{
+					ctx:                context.Background(),
+					taskBroker:         mockBroker,
+					started:            true,
+					defaultTaskTimeout: tt.defaultTimeout,
+					statuses:           store.NewInMemoryKVStore[string, task.Status](),
+				}
+
+				var submittedTask task.Task
+
+				mockBroker.On("Submit", mock.Anything, mock.Anything).Once().Return(nil).Run(func(args mock.Arguments) {
+					submittedTask, _ = args.Get(1).(task.Task)
+				})
+
+				// Act
+				_, err := gf.Push("exampleTask", "examplePayload", tt.opts...)
+
+				// Assert
+				assert.Nil(t, err)
+				assert.Equal(t, tt.wantTimeout, submittedTask.Timeout)
+			})
+		}
+	})
+
 	t.Run("Returns an error if task submission fails", func(t *testing.T) {
 		// Arrange
 		mockBroker := new(mockBroker[task.Task])
@@ -302,6 +424,7 @@ func Test_GoFlow_Push(t *testing.T) {
 			ctx:        ctx,
 			taskBroker: mockBroker,
 			started:    true,
+			statuses:   store.NewInMemoryKVStore[string, task.Status](),
 		}
 
 		submissionError := errors.New("submission error")
@@ -326,6 +449,7 @@ func Test_GoFlow_Push(t *testing.T) {
 			ctx:        ctx,
 			taskBroker: mockBroker,
 			started:    false,
+			statuses:   store.NewInMemoryKVStore[string, task.Status](),
 		}
 
 		// Act
@@ -338,193 +462,1626 @@ func Test_GoFlow_Push(t *testing.T) {
 	})
 }
 
-func Test_GoFlow_GetResult(t *testing.T) {
-	t.Run("Returns the result of given taskID if it exists", func(t *testing.T) {
+func Test_GoFlow_PushBatch(t *testing.T) {
+	t.Run("Submits the tasks in a single batch and returns their IDs in order", func(t *testing.T) {
 		// Arrange
-		mockResults := new(mockKVStore[string, task.Result])
+		mockBroker := new(mockBatchBroker[task.Task])
 
 		gf := GoFlow{
-			results: mockResults,
-			started: true,
+			ctx:        context.Background(),
+			taskBroker: mockBroker,
+			started:    true,
+			statuses:   store.NewInMemoryKVStore[string, task.Status](),
 		}
 
-		taskID := "taskID"
+		var batch []task.Task
 
-		expectedResult := task.Result{Payload: "result"}
+		mockBroker.On("SubmitBatch", mock.Anything, mock.Anything).Once().Return(nil).Run(func(args mock.Arguments) {
+			batch, _ = args.Get(1).([]task.Task)
+		})
 
-		mockResults.On("Get", mock.Anything).Once().Return(expectedResult, true)
+		// Act
+		ids, err := gf.PushBatch([]PushRequest{
+			{TaskType: "resize", Payload: "a"},
+			{TaskType: "index", Payload: "b", Opts: []PushOption{WithPriority(3)}},
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, batch, 2)
+		assert.Equal(t, []string{batch[0].ID, batch[1].ID}, ids)
+		assert.Equal(t, "resize", batch[0].Type)
+		assert.Equal(t, "b", batch[1].Payload)
+		assert.Equal(t, 3, batch[1].Priority)
+
+		status, found := gf.statuses.Get(ids[1])
+		assert.True(t, found)
+		assert.Equal(t, task.StatePending, status.State)
+
+		mockBroker.AssertNotCalled(t, "Submit", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Submits one at a time to brokers without batch support", func(t *testing.T) {
+		// Arrange
+		mockBroker := new(mockBroker[task.Task])
+
+		gf := GoFlow{
+			ctx:        context.Background(),
+			taskBroker: mockBroker,
+			started:    true,
+			statuses:   store.NewInMemoryKVStore[string, task.Status](),
+		}
+
+		mockBroker.On("Submit", mock.Anything, mock.Anything).Twice().Return(nil)
 
 		// Act
-		result, ok, err := gf.GetResult(taskID)
+		ids, err := gf.PushBatch([]PushRequest{{TaskType: "resize"}, {TaskType: "resize"}})
 
 		// Assert
-		assert.Nil(t, err)
-		assert.Equal(t, expectedResult, result)
-		assert.True(t, ok)
+		assert.NoError(t, err)
+		assert.Len(t, ids, 2)
+		mockBroker.AssertExpectations(t)
 	})
 
-	t.Run("Returns false if given taskID doesn't exist", func(t *testing.T) {
+	t.Run("Holds delayed tasks back individually", func(t *testing.T) {
 		// Arrange
-		mockResults := new(mockKVStore[string, task.Result])
+		mockBroker := new(mockDelayedBroker[task.Task])
 
 		gf := GoFlow{
-			results: mockResults,
-			started: true,
+			ctx:        context.Background(),
+			taskBroker: mockBroker,
+			started:    true,
+			statuses:   store.NewInMemoryKVStore[string, task.Status](),
 		}
 
-		taskID := "taskID"
+		runAt := time.Now().Add(time.Hour)
 
-		expectedResult := task.Result{}
+		mockBroker.On("Submit", mock.Anything, mock.Anything).Once().Return(nil)
+		mockBroker.On("SubmitAt", mock.Anything, mock.Anything, runAt).Once().Return(nil)
 
-		mockResults.On("Get", mock.Anything).Once().Return(expectedResult, false)
+		// Act
+		_, err := gf.PushBatch([]PushRequest{
+			{TaskType: "resize"},
+			{TaskType: "resize", Opts: []PushOption{WithRunAt(runAt)}},
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		mockBroker.AssertExpectations(t)
+	})
+
+	t.Run("Validates every request before submitting anything", func(t *testing.T) {
+		// Arrange
+		mockBroker := new(mockBatchBroker[task.Task])
+
+		gf := GoFlow{
+			ctx:        context.Background(),
+			taskBroker: mockBroker,
+			started:    true,
+			statuses:   store.NewInMemoryKVStore[string, task.Status](),
+		}
 
 		// Act
-		result, ok, err := gf.GetResult(taskID)
+		ids, err := gf.PushBatch([]PushRequest{
+			{TaskType: "resize"},
+			{TaskType: "resize", Opts: []PushOption{WithPriority(task.MaxPriority + 1)}},
+		})
 
 		// Assert
-		assert.Nil(t, err)
-		assert.Equal(t, expectedResult, result)
-		assert.False(t, ok)
+		assert.ErrorIs(t, err, ErrInvalidPriority)
+		assert.Nil(t, ids)
+		mockBroker.AssertNotCalled(t, "SubmitBatch", mock.Anything, mock.Anything)
 	})
 
-	t.Run("Returns ErrNotStarted if GoFlow instance isn't started", func(t *testing.T) {
+	t.Run("Returns an error if submitting the batch fails", func(t *testing.T) {
 		// Arrange
-		mockResults := new(mockKVStore[string, task.Result])
+		mockBroker := new(mockBatchBroker[task.Task])
 
 		gf := GoFlow{
-			results: mockResults,
-			started: false,
+			ctx:        context.Background(),
+			taskBroker: mockBroker,
+			started:    true,
+			statuses:   store.NewInMemoryKVStore[string, task.Status](),
 		}
 
-		taskID := "taskID"
+		submitErr := errors.New("broker down")
+		mockBroker.On("SubmitBatch", mock.Anything, mock.Anything).Once().Return(submitErr)
 
 		// Act
-		result, ok, err := gf.GetResult(taskID)
+		ids, err := gf.PushBatch([]PushRequest{{TaskType: "resize"}})
 
 		// Assert
-		assert.EqualError(t, err, ErrNotStarted.Error())
-		assert.False(t, ok)
-		assert.Equal(t, task.Result{}, result)
-		mockResults.AssertExpectations(t)
+		assert.ErrorIs(t, err, submitErr)
+		assert.Nil(t, ids)
 	})
-}
 
-func Test_GoFlow_Stop(t *testing.T) {
-	t.Run("Calls cancel and waits for all components to shut down", func(t *testing.T) {
+	t.Run("Returns ErrNotStarted if GoFlow is not started", func(t *testing.T) {
 		// Arrange
-		wasCancelCalled := false
-		mockCancel := func() {
-			wasCancelCalled = true
+		gf := GoFlow{}
+
+		// Act
+		_, err := gf.PushBatch([]PushRequest{{TaskType: "resize"}})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotStarted)
+	})
+}
+
+func Test_GoFlow_IdempotencyKey(t *testing.T) {
+	newGoFlow := func(taskBroker Broker[task.Task]) *GoFlow {
+		return &GoFlow{
+			ctx:               context.Background(),
+			taskBroker:        taskBroker,
+			started:           true,
+			statuses:          store.NewInMemoryKVStore[string, task.Status](),
+			idempotency:       idempotency.NewInMemoryStore(),
+			idempotencyWindow: time.Minute,
 		}
+	}
 
-		mockWorkerPool := &mockWorkerPool{}
-		mockWorkerPool.On("AwaitShutdown").Once()
+	t.Run("Returns the original task ID instead of pushing a duplicate", func(t *testing.T) {
+		// Arrange
+		mockBroker := new(mockBroker[task.Task])
+		gf := newGoFlow(mockBroker)
 
-		mockTaskBroker := &mockBroker[task.Task]{}
-		mockTaskBroker.On("AwaitShutdown").Once()
+		mockBroker.On("Submit", mock.Anything, mock.Anything).Twice().Return(nil)
 
-		mockResultBroker := &mockBroker[task.Result]{}
-		mockResultBroker.On("AwaitShutdown").Once()
+		// Act
+		firstID, firstErr := gf.Push("exampleTask", "examplePayload", WithIdempotencyKey("key"))
+		secondID, secondErr := gf.Push("exampleTask", "examplePayload", WithIdempotencyKey("key"))
+		otherID, otherErr := gf.Push("exampleTask", "examplePayload", WithIdempotencyKey("other"))
 
-		gf := GoFlow{
-			cancel:          mockCancel,
-			workers:         mockWorkerPool,
-			taskBroker:      mockTaskBroker,
-			resultsBroker:   mockResultBroker,
-			resultsWriterWG: &sync.WaitGroup{},
-			started:         true,
-		}
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.NoError(t, secondErr)
+		assert.NoError(t, otherErr)
+		assert.Equal(t, firstID, secondID)
+		assert.NotEqual(t, firstID, otherID)
+
+		mockBroker.AssertNumberOfCalls(t, "Submit", 2)
+	})
+
+	t.Run("Frees the key if the task cannot be submitted", func(t *testing.T) {
+		// Arrange
+		mockBroker := new(mockBroker[task.Task])
+		gf := newGoFlow(mockBroker)
+
+		mockBroker.On("Submit", mock.Anything, mock.Anything).Once().Return(errors.New("broker down"))
+		mockBroker.On("Submit", mock.Anything, mock.Anything).Once().Return(nil)
 
 		// Act
-		err := gf.Close()
+		_, failedErr := gf.Push("exampleTask", "examplePayload", WithIdempotencyKey("key"))
+		retriedID, retriedErr := gf.Push("exampleTask", "examplePayload", WithIdempotencyKey("key"))
 
 		// Assert
-		assert.Nil(t, err)
-		assert.True(t, wasCancelCalled)
+		assert.Error(t, failedErr)
+		assert.NoError(t, retriedErr)
 
-		mockWorkerPool.AssertExpectations(t)
-		mockTaskBroker.AssertExpectations(t)
-		mockResultBroker.AssertExpectations(t)
+		status, found := gf.statuses.Get(retriedID)
+		assert.True(t, found)
+		assert.Equal(t, task.StatePending, status.State)
 	})
 
-	t.Run("Returns ErrNotStarted if GoFlow instance not started", func(t *testing.T) {
+	t.Run("Suppresses duplicates within a batch and of earlier pushes", func(t *testing.T) {
 		// Arrange
-		wasCancelCalled := false
-		mockCancel := func() {
-			wasCancelCalled = true
-		}
+		mockBroker := new(mockBatchBroker[task.Task])
+		gf := newGoFlow(mockBroker)
 
-		mockWorkerPool := &mockWorkerPool{}
-		mockTaskBroker := &mockBroker[task.Task]{}
-		mockResultBroker := &mockBroker[task.Result]{}
+		mockBroker.On("Submit", mock.Anything, mock.Anything).Once().Return(nil)
 
-		gf := GoFlow{
-			cancel:          mockCancel,
-			workers:         mockWorkerPool,
-			taskBroker:      mockTaskBroker,
-			resultsBroker:   mockResultBroker,
-			resultsWriterWG: &sync.WaitGroup{},
-			started:         false,
-		}
+		var batch []task.Task
+
+		mockBroker.On("SubmitBatch", mock.Anything, mock.Anything).Once().Return(nil).Run(func(args mock.Arguments) {
+			batch, _ = args.Get(1).([]task.Task)
+		})
+
+		earlierID, _ := gf.Push("exampleTask", "examplePayload", WithIdempotencyKey("earlier"))
 
 		// Act
-		err := gf.Close()
+		ids, err := gf.PushBatch([]PushRequest{
+			{TaskType: "exampleTask", Opts: []PushOption{WithIdempotencyKey("earlier")}},
+			{TaskType: "exampleTask", Opts: []PushOption{WithIdempotencyKey("new")}},
+			{TaskType: "exampleTask", Opts: []PushOption{WithIdempotencyKey("new")}},
+			{TaskType: "exampleTask"},
+		})
 
 		// Assert
-		assert.EqualError(t, err, ErrNotStarted.Error())
-		assert.False(t, wasCancelCalled)
-		assert.False(t, gf.started)
+		assert.NoError(t, err)
+		assert.Len(t, batch, 2)
+		assert.Equal(t, []string{earlierID, batch[0].ID, batch[0].ID, batch[1].ID}, ids)
+	})
 
-		mockWorkerPool.AssertExpectations(t)
-		mockTaskBroker.AssertExpectations(t)
-		mockResultBroker.AssertExpectations(t)
+	t.Run("Frees the keys of unsubmitted tasks and records the pushed ones if a batch fails part way", func(t *testing.T) {
+		// Arrange
+		mockBroker := new(mockDelayedBroker[task.Task])
+		gf := newGoFlow(mockBroker)
+
+		first := time.Now().Add(time.Hour)
+		second := first.Add(time.Hour)
+		submitErr := errors.New("broker down")
+
+		mockBroker.On("Submit", mock.Anything, mock.Anything).Once().Return(nil)
+		mockBroker.On("SubmitAt", mock.Anything, mock.Anything, first).Once().Return(nil)
+		mockBroker.On("SubmitAt", mock.Anything, mock.Anything, second).Once().Return(submitErr)
+
+		// Act
+		ids, err := gf.PushBatch([]PushRequest{
+			{TaskType: "exampleTask", Opts: []PushOption{WithIdempotencyKey("now")}},
+			{TaskType: "exampleTask", Opts: []PushOption{WithIdempotencyKey("first"), WithRunAt(first)}},
+			{TaskType: "exampleTask", Opts: []PushOption{WithIdempotencyKey("second"), WithRunAt(second)}},
+		})
+
+		// Assert
+		assert.Nil(t, ids)
+		assert.ErrorIs(t, err, submitErr)
+
+		var partialErr *PartialPushError
+		assert.ErrorAs(t, err, &partialErr)
+		assert.Len(t, partialErr.IDs, 3)
+		assert.NotEmpty(t, partialErr.IDs[0])
+		assert.NotEmpty(t, partialErr.IDs[1])
+		assert.Empty(t, partialErr.IDs[2])
+
+		for _, id := range partialErr.IDs[:2] {
+			status, found := gf.statuses.Get(id)
+			assert.True(t, found)
+			assert.Equal(t, task.StatePending, status.State)
+		}
+
+		mockBroker.On("SubmitAt", mock.Anything, mock.Anything, second).Once().Return(nil)
+
+		retriedID, retriedErr := gf.Push(
+			"exampleTask", "examplePayload", WithIdempotencyKey("second"), WithRunAt(second),
+		)
+		assert.NoError(t, retriedErr)
+		assert.NotEqual(t, partialErr.IDs[1], retriedID)
 	})
-}
 
-type mockWorkerPool struct {
-	mock.Mock
-}
+	t.Run("Keeps the keys of tasks pushed before a broker without batch support fails", func(t *testing.T) {
+		// Arrange
+		mockBroker := new(mockBroker[task.Task])
+		gf := newGoFlow(mockBroker)
 
-func (m *mockWorkerPool) Start(
-	ctx context.Context,
-	taskQueue task.Dequeuer[task.Task],
-	results task.Submitter[task.Result],
-	taskHandlers workerpool.HandlerGetter,
-) {
-	m.Called(ctx, taskQueue, results, taskHandlers)
-}
+		submitErr := errors.New("broker down")
 
-func (m *mockWorkerPool) AwaitShutdown() {
-	m.Called()
-}
+		mockBroker.On("Submit", mock.Anything, mock.Anything).Once().Return(nil)
+		mockBroker.On("Submit", mock.Anything, mock.Anything).Once().Return(submitErr)
 
-type mockBroker[T any] struct {
-	mock.Mock
-}
+		// Act
+		_, err := gf.PushBatch([]PushRequest{
+			{TaskType: "exampleTask", Opts: []PushOption{WithIdempotencyKey("pushed")}},
+			{TaskType: "exampleTask", Opts: []PushOption{WithIdempotencyKey("failed")}},
+		})
 
-func (m *mockBroker[T]) Submit(ctx context.Context, tsk T) error {
-	args := m.Called(ctx, tsk)
-	return args.Error(0)
-}
+		// Assert
+		var partialErr *PartialPushError
+		assert.ErrorAs(t, err, &partialErr)
+		assert.ErrorIs(t, err, submitErr)
+		assert.NotEmpty(t, partialErr.IDs[0])
+		assert.Empty(t, partialErr.IDs[1])
 
-func (m *mockBroker[T]) Dequeue(ctx context.Context) <-chan T {
-	args := m.Called(ctx)
-	return args.Get(0).(<-chan T)
-}
+		status, found := gf.statuses.Get(partialErr.IDs[0])
+		assert.True(t, found)
+		assert.Equal(t, task.StatePending, status.State)
 
-func (m *mockBroker[T]) AwaitShutdown() {
-	m.Called()
-}
+		mockBroker.On("Submit", mock.Anything, mock.Anything).Once().Return(nil)
 
-type mockKVStore[K comparable, V any] struct {
-	mock.Mock
-}
+		duplicateID, duplicateErr := gf.Push("exampleTask", "examplePayload", WithIdempotencyKey("pushed"))
+		_, retriedErr := gf.Push("exampleTask", "examplePayload", WithIdempotencyKey("failed"))
 
-func (m *mockKVStore[K, V]) Put(key K, value V) {
-	m.Called(key, value)
+		assert.NoError(t, duplicateErr)
+		assert.Equal(t, partialErr.IDs[0], duplicateID)
+		assert.NoError(t, retriedErr)
+		mockBroker.AssertNumberOfCalls(t, "Submit", 3)
+	})
+
+	t.Run("Returns an error if the key cannot be claimed", func(t *testing.T) {
+		// Arrange
+		mockBroker := new(mockBroker[task.Task])
+		gf := newGoFlow(mockBroker)
+
+		idempotencyStore := new(mockIdempotencyStore)
+		gf.idempotency = idempotencyStore
+
+		storeErr := errors.New("redis down")
+		idempotencyStore.On("Claim", mock.Anything, "key", mock.Anything, time.Minute).Return("", false, storeErr)
+
+		// Act
+		_, err := gf.Push("exampleTask", "examplePayload", WithIdempotencyKey("key"))
+
+		// Assert
+		assert.ErrorIs(t, err, storeErr)
+		mockBroker.AssertNotCalled(t, "Submit", mock.Anything, mock.Anything)
+	})
 }
 
-func (m *mockKVStore[K, V]) Get(key K) (V, bool) {
-	args := m.Called(key)
-	return args.Get(0).(V), args.Bool(1)
+func Test_GoFlow_PushWithMetadata(t *testing.T) {
+	t.Run("Submits the task with a copy of the merged metadata and records it in its status", func(t *testing.T) {
+		// Arrange
+		mockBroker := new(mockBroker[task.Task])
+
+		ctx := context.Background()
+
+		gf := GoFlow{
+			ctx:        ctx,
+			taskBroker: mockBroker,
+			started:    true,
+			statuses:   store.NewInMemoryKVStore[string, task.Status](),
+		}
+
+		var submittedTask task.Task
+
+		mockBroker.On("Submit", ctx, mock.Anything).Once().Return(nil).Run(func(args mock.Arguments) {
+			submittedTask, _ = args.Get(1).(task.Task)
+		})
+
+		metadata := map[string]string{"trace_id": "abc", "tenant": "acme"}
+
+		// Act
+		taskID, err := gf.Push(
+			"exampleTask",
+			"examplePayload",
+			WithMetadata(metadata),
+			WithMetadata(map[string]string{"tenant": "other"}),
+		)
+
+		metadata["trace_id"] = "changed"
+
+		// Assert
+		expected := map[string]string{"trace_id": "abc", "tenant": "other"}
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, submittedTask.Metadata)
+
+		status, _ := gf.statuses.Get(taskID)
+		assert.Equal(t, expected, status.Metadata)
+	})
+}
+
+func Test_GoFlow_PushWithPriority(t *testing.T) {
+	t.Run("Submits the task with the given priority", func(t *testing.T) {
+		// Arrange
+		mockBroker := new(mockBroker[task.Task])
+
+		ctx := context.Background()
+
+		gf := GoFlow{
+			ctx:        ctx,
+			taskBroker: mockBroker,
+			started:    true,
+			statuses:   store.NewInMemoryKVStore[string, task.Status](),
+		}
+
+		var submittedTask task.Task
+
+		mockBroker.On("Submit", ctx, mock.Anything).Once().Return(nil).Run(func(args mock.Arguments) {
+			submittedTask, _ = args.Get(1).(task.Task)
+		})
+
+		// Act
+		taskID, err := gf.PushWithPriority("exampleTask", "examplePayload", 7)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, submittedTask.ID, taskID)
+		assert.Equal(t, 7, submittedTask.Priority)
+	})
+
+	for _, priority := range []int{-1, task.MaxPriority + 1} {
+		t.Run(fmt.Sprintf("Returns an error for priority %d", priority), func(t *testing.T) {
+			// Arrange
+			mockBroker := new(mockBroker[task.Task])
+
+			gf := GoFlow{
+				ctx:        context.Background(),
+				taskBroker: mockBroker,
+				started:    true,
+				statuses:   store.NewInMemoryKVStore[string, task.Status](),
+			}
+
+			// Act
+			_, err := gf.PushWithPriority("exampleTask", "examplePayload", priority)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidPriority)
+			mockBroker.AssertNotCalled(t, "Submit")
+		})
+	}
+}
+
+func Test_GoFlow_PushAt(t *testing.T) {
+	t.Run("Submits the task to the broker to be delivered at the given time", func(t *testing.T) {
+		// Arrange
+		mockBroker := new(mockDelayedBroker[task.Task])
+
+		ctx := context.Background()
+
+		gf := GoFlow{
+			ctx:        ctx,
+			taskBroker: mockBroker,
+			started:    true,
+			statuses:   store.NewInMemoryKVStore[string, task.Status](),
+		}
+
+		at := time.Now().Add(time.Hour)
+
+		var submittedTask task.Task
+
+		mockBroker.On("SubmitAt", ctx, mock.Anything, at).Once().Return(nil).Run(func(args mock.Arguments) {
+			submittedTask, _ = args.Get(1).(task.Task)
+		})
+
+		// Act
+		taskID, err := gf.PushAt("exampleTask", "examplePayload", at)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, submittedTask.ID, taskID)
+		assert.Equal(t, "exampleTask", submittedTask.Type)
+
+		mockBroker.AssertExpectations(t)
+		mockBroker.AssertNotCalled(t, "Submit")
+	})
+
+	t.Run("Returns an error if the broker does not support delayed submission", func(t *testing.T) {
+		// Arrange
+		mockBroker := new(mockBroker[task.Task])
+
+		gf := GoFlow{
+			ctx:        context.Background(),
+			taskBroker: mockBroker,
+			started:    true,
+			statuses:   store.NewInMemoryKVStore[string, task.Status](),
+		}
+
+		// Act
+		_, err := gf.PushAfter("exampleTask", "examplePayload", time.Minute)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrDelayedPushUnsupported)
+		mockBroker.AssertNotCalled(t, "Submit")
+	})
+}
+
+func Test_GoFlow_Schedules(t *testing.T) {
+	t.Run("Adds, lists and removes schedules", func(t *testing.T) {
+		// Arrange
+		gf := GoFlow{
+			ctx:       context.Background(),
+			scheduler: schedule.NewScheduler(schedule.NewInMemoryStore(), broker.NewChannelBroker[task.Task](1)),
+			started:   true,
+			statuses:  store.NewInMemoryKVStore[string, task.Status](),
+		}
+
+		// Act
+		scheduleID, addErr := gf.AddSchedule("report", "payload", "0 2 * * *")
+		listed, listErr := gf.ListSchedules()
+		removeErr := gf.RemoveSchedule(scheduleID)
+		secondRemoveErr := gf.RemoveSchedule(scheduleID)
+
+		// Assert
+		assert.NoError(t, addErr)
+		assert.NoError(t, listErr)
+		assert.Len(t, listed, 1)
+		assert.Equal(t, scheduleID, listed[0].ID)
+		assert.Equal(t, "report", listed[0].TaskType)
+		assert.Equal(t, "payload", listed[0].Payload)
+		assert.Equal(t, "0 2 * * *", listed[0].Spec)
+		assert.NoError(t, removeErr)
+		assert.ErrorIs(t, secondRemoveErr, schedule.ErrNotFound)
+	})
+
+	t.Run("Returns an error if GoFlow is not started", func(t *testing.T) {
+		// Arrange
+		gf := GoFlow{started: false}
+
+		// Act
+		_, addErr := gf.AddSchedule("report", "payload", "@daily")
+		_, listErr := gf.ListSchedules()
+		removeErr := gf.RemoveSchedule("id")
+
+		// Assert
+		assert.ErrorIs(t, addErr, ErrNotStarted)
+		assert.ErrorIs(t, listErr, ErrNotStarted)
+		assert.ErrorIs(t, removeErr, ErrNotStarted)
+	})
+
+	t.Run("Applies the default task timeout to scheduled tasks and records them as pending", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		taskBroker := broker.NewChannelBroker[task.Task](1)
+
+		gf := &GoFlow{
+			ctx:                ctx,
+			taskBroker:         taskBroker,
+			defaultTaskTimeout: time.Minute,
+			statuses:           store.NewInMemoryKVStore[string, task.Status](),
+		}
+
+		// Act
+		err := scheduledTaskSubmitter{gf: gf}.Submit(ctx, task.New("report", "payload"))
+
+		// Assert
+		assert.NoError(t, err)
+
+		submitted := <-taskBroker.Dequeue(ctx)
+		assert.Equal(t, time.Minute, submitted.Timeout)
+
+		status, found := gf.statuses.Get(submitted.ID)
+		assert.True(t, found)
+		assert.Equal(t, task.StatePending, status.State)
+		assert.Equal(t, "report", status.TaskType)
+	})
+}
+
+func Test_GoFlow_GetStatus(t *testing.T) {
+	t.Run("Tracks a task from pending to finished", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskBroker := broker.NewChannelBroker[task.Task](1)
+		resultsBroker := broker.NewChannelBroker[task.Result](0)
+
+		gf := &GoFlow{
+			ctx:             ctx,
+			cancel:          cancel,
+			taskBroker:      taskBroker,
+			resultsBroker:   resultsBroker,
+			results:         store.NewInMemoryKVStore[string, task.Result](),
+			statuses:        store.NewInMemoryKVStore[string, task.Status](),
+			resultsWriterWG: &sync.WaitGroup{},
+		}
+
+		_ = gf.Start()
+
+		// Act
+		taskID, _ := gf.Push("exampleTask", "examplePayload")
+		pending, pendingFound, _ := gf.GetStatus(taskID)
+
+		pushed := <-taskBroker.Dequeue(ctx)
+		startedAt := time.Now()
+
+		_ = resultsBroker.Submit(ctx, task.Result{
+			TaskID:     taskID,
+			State:      task.StateRunning,
+			Attempt:    1,
+			EnqueuedAt: pushed.EnqueuedAt,
+			StartedAt:  startedAt,
+		})
+
+		// Submitting the next result guarantees the previous one was persisted
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "other"})
+
+		running, _, _ := gf.GetStatus(taskID)
+		_, runningResultFound, _ := gf.GetResult(taskID)
+
+		finishedAt := time.Now()
+
+		_ = resultsBroker.Submit(ctx, task.Result{
+			TaskID:     taskID,
+			Payload:    "done",
+			State:      task.StateSucceeded,
+			Attempt:    1,
+			EnqueuedAt: pushed.EnqueuedAt,
+			StartedAt:  startedAt,
+			FinishedAt: finishedAt,
+		})
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "other"})
+
+		finished, _, _ := gf.GetStatus(taskID)
+		result, resultFound, _ := gf.GetResult(taskID)
+
+		_ = gf.Close()
+
+		// Assert
+		assert.True(t, pendingFound)
+		assert.Equal(t, task.Status{TaskID: taskID, TaskType: "exampleTask", State: task.StatePending, Attempt: 1, EnqueuedAt: pushed.EnqueuedAt}, pending)
+
+		assert.Equal(t, task.StateRunning, running.State)
+		assert.Equal(t, startedAt, running.StartedAt)
+		assert.False(t, runningResultFound)
+
+		assert.Equal(t, task.Status{
+			TaskID:     taskID,
+			State:      task.StateSucceeded,
+			Attempt:    1,
+			EnqueuedAt: pushed.EnqueuedAt,
+			StartedAt:  startedAt,
+			FinishedAt: finishedAt,
+		}, finished)
+		assert.True(t, resultFound)
+		assert.Equal(t, "done", result.Payload)
+	})
+
+	t.Run("Ignores status updates that arrive after the task finished", func(t *testing.T) {
+		// Arrange
+		statuses := store.NewInMemoryKVStore[string, task.Status]()
+		statuses.Put("taskID", task.Status{TaskID: "taskID", State: task.StateFailed, Attempt: 1})
+
+		gf := GoFlow{statuses: statuses}
+
+		// Act
+		gf.updateStatus(task.Status{TaskID: "taskID", State: task.StateRunning, Attempt: 1})
+
+		// Assert
+		status, _ := statuses.Get("taskID")
+		assert.Equal(t, task.StateFailed, status.State)
+	})
+
+	t.Run("Prefers the result to a status recorded out of order by another instance", func(t *testing.T) {
+		// Arrange
+		results := store.NewInMemoryKVStore[string, task.Result]()
+		results.Put("taskID", task.Result{TaskID: "taskID", State: task.StateSucceeded, Attempt: 1})
+
+		statuses := store.NewInMemoryKVStore[string, task.Status]()
+		statuses.Put("taskID", task.Status{TaskID: "taskID", State: task.StateRunning, Attempt: 1})
+
+		gf := GoFlow{results: results, statuses: statuses, started: true}
+
+		// Act
+		status, found, err := gf.GetStatus("taskID")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, task.StateSucceeded, status.State)
+	})
+
+	t.Run("Expires statuses with the result TTL", func(t *testing.T) {
+		// Arrange
+		gf := GoFlow{
+			results:   store.NewInMemoryKVStore[string, task.Result](),
+			statuses:  store.NewInMemoryKVStore[string, task.Status](),
+			started:   true,
+			resultTTL: 20 * time.Millisecond,
+		}
+
+		// Act
+		gf.updateStatus(task.Status{TaskID: "taskID", State: task.StatePending, Attempt: 1})
+		_, foundBeforeTTL, _ := gf.GetStatus("taskID")
+
+		// Assert
+		assert.True(t, foundBeforeTTL)
+		assert.Eventually(t, func() bool {
+			_, found, _ := gf.GetStatus("taskID")
+			return !found
+		}, 5*time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("Falls back to the status recorded by the result", func(t *testing.T) {
+		// Arrange
+		results := store.NewInMemoryKVStore[string, task.Result]()
+		results.Put("taskID", task.Result{TaskID: "taskID", ErrMsg: "failed"})
+
+		gf := GoFlow{
+			results:  results,
+			statuses: store.NewInMemoryKVStore[string, task.Status](),
+			started:  true,
+		}
+
+		// Act
+		status, ok, err := gf.GetStatus("taskID")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, task.StateFailed, status.State)
+	})
+
+	t.Run("Returns false if the task does not exist", func(t *testing.T) {
+		// Arrange
+		gf := GoFlow{
+			results:  store.NewInMemoryKVStore[string, task.Result](),
+			statuses: store.NewInMemoryKVStore[string, task.Status](),
+			started:  true,
+		}
+
+		// Act
+		_, ok, err := gf.GetStatus("taskID")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Returns an error if GoFlow is not started", func(t *testing.T) {
+		// Arrange
+		gf := GoFlow{started: false}
+
+		// Act
+		_, _, err := gf.GetStatus("taskID")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotStarted)
+	})
+}
+
+func Test_GoFlow_Cancel(t *testing.T) {
+	newStartedGoFlow := func() (*GoFlow, *broker.ChannelBroker[task.Task], *broker.ChannelBroker[task.Result]) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskBroker := broker.NewChannelBroker[task.Task](1)
+		resultsBroker := broker.NewChannelBroker[task.Result](0)
+
+		gf := &GoFlow{
+			ctx:             ctx,
+			cancel:          cancel,
+			taskBroker:      taskBroker,
+			resultsBroker:   resultsBroker,
+			results:         store.NewInMemoryKVStore[string, task.Result](),
+			statuses:        store.NewInMemoryKVStore[string, task.Status](),
+			resultsWriterWG: &sync.WaitGroup{},
+		}
+
+		_ = gf.Start()
+
+		return gf, taskBroker, resultsBroker
+	}
+
+	t.Run("Cancels a pending task and writes its cancelled result", func(t *testing.T) {
+		// Arrange
+		gf, taskBroker, _ := newStartedGoFlow()
+		defer gf.Close()
+
+		taskID, _ := gf.Push("exampleTask", "examplePayload")
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		// Act
+		err := gf.Cancel(taskID)
+		result, awaitErr := gf.Await(ctx, taskID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, awaitErr)
+		assert.Equal(t, task.StateCancelled, result.State)
+		assert.Equal(t, task.CancelledErrMsg, result.ErrMsg)
+
+		cancelled, _ := taskBroker.Cancelled(ctx, taskID)
+		assert.True(t, cancelled)
+
+		status, _, _ := gf.GetStatus(taskID)
+		assert.Equal(t, task.StateCancelled, status.State)
+	})
+
+	t.Run("Cancels a task pushed through another instance sharing its status store", func(t *testing.T) {
+		// Arrange
+		taskBroker := broker.NewChannelBroker[task.Task](1)
+		resultsBroker := new(mockBroker[task.Result])
+		statuses := store.NewInMemoryKVStore[string, task.Status]()
+
+		newInstance := func() *GoFlow {
+			return &GoFlow{
+				ctx:           context.Background(),
+				taskBroker:    taskBroker,
+				resultsBroker: resultsBroker,
+				results:       store.NewInMemoryKVStore[string, task.Result](),
+				statuses:      statuses,
+				started:       true,
+			}
+		}
+
+		pushedThrough, cancelledThrough := newInstance(), newInstance()
+
+		resultsBroker.On("Submit", mock.Anything, mock.Anything).Once().Return(nil)
+
+		taskID, _ := pushedThrough.Push("exampleTask", "examplePayload")
+
+		// Act
+		err := cancelledThrough.Cancel(taskID)
+
+		// Assert
+		assert.NoError(t, err)
+
+		cancelled, _ := taskBroker.Cancelled(context.Background(), taskID)
+		assert.True(t, cancelled)
+		resultsBroker.AssertExpectations(t)
+	})
+
+	t.Run("Leaves the result of a running task to its worker", func(t *testing.T) {
+		// Arrange
+		taskBroker := broker.NewChannelBroker[task.Task](1)
+		resultsBroker := new(mockBroker[task.Result])
+
+		statuses := store.NewInMemoryKVStore[string, task.Status]()
+		statuses.Put("taskID", task.Status{TaskID: "taskID", State: task.StateRunning, Attempt: 1})
+
+		gf := GoFlow{
+			ctx:           context.Background(),
+			taskBroker:    taskBroker,
+			resultsBroker: resultsBroker,
+			results:       store.NewInMemoryKVStore[string, task.Result](),
+			statuses:      statuses,
+			started:       true,
+		}
+
+		// Act
+		err := gf.Cancel("taskID")
+
+		// Assert
+		assert.NoError(t, err)
+
+		cancelled, _ := taskBroker.Cancelled(context.Background(), "taskID")
+		assert.True(t, cancelled)
+		resultsBroker.AssertNotCalled(t, "Submit", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Drops results that arrive after the cancellation", func(t *testing.T) {
+		// Arrange
+		gf, _, resultsBroker := newStartedGoFlow()
+		defer gf.Close()
+
+		taskID, _ := gf.Push("exampleTask", "examplePayload")
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_ = gf.Cancel(taskID)
+		_, _ = gf.Await(ctx, taskID)
+
+		// Act
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: taskID, Payload: "done", State: task.StateSucceeded})
+
+		// Submitting the next result guarantees the previous one was handled
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "other"})
+
+		result, _, _ := gf.GetResult(taskID)
+
+		// Assert
+		assert.Equal(t, task.StateCancelled, result.State)
+		assert.Nil(t, result.Payload)
+	})
+
+	tests := []struct {
+		name  string
+		setup func(gf *GoFlow)
+		err   error
+	}{
+		{
+			name:  "Returns ErrNotStarted if GoFlow is not started",
+			setup: func(gf *GoFlow) { gf.started = false },
+			err:   ErrNotStarted,
+		},
+		{
+			name:  "Returns ErrCancelUnsupported if the task broker cannot cancel tasks",
+			setup: func(gf *GoFlow) { gf.taskBroker = new(mockBroker[task.Task]) },
+			err:   ErrCancelUnsupported,
+		},
+		{
+			name:  "Returns ErrTaskNotFound for an unknown task",
+			setup: func(_ *GoFlow) {},
+			err:   ErrTaskNotFound,
+		},
+		{
+			name: "Returns ErrTaskFinished for a task that has finished",
+			setup: func(gf *GoFlow) {
+				gf.statuses.Put("taskID", task.Status{TaskID: "taskID", State: task.StateSucceeded})
+			},
+			err: ErrTaskFinished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			gf := &GoFlow{
+				ctx:        context.Background(),
+				taskBroker: broker.NewChannelBroker[task.Task](1),
+				results:    store.NewInMemoryKVStore[string, task.Result](),
+				statuses:   store.NewInMemoryKVStore[string, task.Status](),
+				started:    true,
+			}
+
+			tt.setup(gf)
+
+			// Act
+			err := gf.Cancel("taskID")
+
+			// Assert
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func Test_GoFlow_Await(t *testing.T) {
+	newStartedGoFlow := func() (*GoFlow, *broker.ChannelBroker[task.Result], KVStore[string, task.Result]) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		resultsBroker := broker.NewChannelBroker[task.Result](0)
+		results := store.NewInMemoryKVStore[string, task.Result]()
+
+		gf := &GoFlow{
+			ctx:                ctx,
+			cancel:             cancel,
+			taskBroker:         broker.NewChannelBroker[task.Task](0),
+			resultsBroker:      resultsBroker,
+			results:            results,
+			statuses:           store.NewInMemoryKVStore[string, task.Status](),
+			resultsWriterWG:    &sync.WaitGroup{},
+			resultPollInterval: time.Hour,
+		}
+
+		_ = gf.Start()
+
+		return gf, resultsBroker, results
+	}
+
+	t.Run("Returns the result as soon as it is persisted", func(t *testing.T) {
+		// Arrange
+		gf, resultsBroker, _ := newStartedGoFlow()
+		defer gf.Close()
+
+		awaited := make(chan task.Result)
+
+		go func() {
+			result, _ := gf.Await(context.Background(), "taskID")
+			awaited <- result
+		}()
+
+		// Wait for the subscription so the hourly poll cannot be what delivers it
+		awaitSubscribed(t, gf, "taskID")
+
+		// Act
+		_ = resultsBroker.Submit(context.Background(), task.Result{TaskID: "taskID", Payload: "done"})
+
+		// Assert
+		select {
+		case result := <-awaited:
+			assert.Equal(t, "done", result.Payload)
+		case <-time.After(time.Second):
+			t.Fatal("Await did not return")
+		}
+	})
+
+	t.Run("Returns a result that is already in the results store", func(t *testing.T) {
+		// Arrange
+		gf, _, results := newStartedGoFlow()
+		defer gf.Close()
+
+		results.Put("taskID", task.Result{TaskID: "taskID", Payload: "done"})
+
+		// Act
+		result, err := gf.Await(context.Background(), "taskID")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "done", result.Payload)
+	})
+
+	t.Run("Polls for results persisted by another instance", func(t *testing.T) {
+		// Arrange
+		gf, _, results := newStartedGoFlow()
+		defer gf.Close()
+
+		gf.resultPollInterval = time.Millisecond
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			results.Put("taskID", task.Result{TaskID: "taskID", Payload: "done"})
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		// Act
+		result, err := gf.Await(ctx, "taskID")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "done", result.Payload)
+	})
+
+	t.Run("Returns the context error if the context is done first", func(t *testing.T) {
+		// Arrange
+		gf, _, _ := newStartedGoFlow()
+		defer gf.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		// Act
+		_, err := gf.Await(ctx, "taskID")
+
+		// Assert
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Empty(t, gf.subscriptions.byTask)
+	})
+
+	t.Run("Returns ErrClosed if GoFlow is closed while waiting", func(t *testing.T) {
+		// Arrange
+		gf, _, _ := newStartedGoFlow()
+
+		errs := make(chan error, 1)
+
+		go func() {
+			_, err := gf.Await(context.Background(), "taskID")
+			errs <- err
+		}()
+
+		awaitSubscribed(t, gf, "taskID")
+
+		// Act
+		_ = gf.Close()
+
+		// Assert
+		assert.ErrorIs(t, <-errs, ErrClosed)
+	})
+
+	t.Run("Returns ErrNotStarted if GoFlow is not started", func(t *testing.T) {
+		// Arrange
+		gf := &GoFlow{}
+
+		// Act
+		_, err := gf.Await(context.Background(), "taskID")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotStarted)
+	})
+}
+
+func Test_GoFlow_Subscribe(t *testing.T) {
+	t.Run("Delivers each result and then closes the channel", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		resultsBroker := broker.NewChannelBroker[task.Result](0)
+		results := store.NewInMemoryKVStore[string, task.Result]()
+		results.Put("first", task.Result{TaskID: "first"})
+
+		gf := &GoFlow{
+			ctx:                ctx,
+			cancel:             cancel,
+			taskBroker:         broker.NewChannelBroker[task.Task](0),
+			resultsBroker:      resultsBroker,
+			results:            results,
+			statuses:           store.NewInMemoryKVStore[string, task.Status](),
+			resultsWriterWG:    &sync.WaitGroup{},
+			resultPollInterval: time.Millisecond,
+		}
+
+		_ = gf.Start()
+		defer gf.Close()
+
+		// Act
+		sub := gf.Subscribe("first", "second", "third")
+
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "third"})
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "unrelated"})
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "second"})
+
+		// Assert
+		received := []string{}
+		for result := range sub {
+			received = append(received, result.TaskID)
+		}
+
+		assert.ElementsMatch(t, []string{"first", "second", "third"}, received)
+	})
+
+	t.Run("Returns a closed channel if GoFlow is not started", func(t *testing.T) {
+		// Arrange
+		gf := &GoFlow{}
+
+		// Act
+		sub := gf.Subscribe("taskID")
+
+		// Assert
+		_, ok := <-sub
+		assert.False(t, ok)
+	})
+}
+
+func Test_GoFlow_Hooks(t *testing.T) {
+	newStartedGoFlow := func(h hooks) (*GoFlow, *broker.ChannelBroker[task.Task], *broker.ChannelBroker[task.Result]) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskBroker := broker.NewChannelBroker[task.Task](1)
+		resultsBroker := broker.NewChannelBroker[task.Result](0)
+
+		gf := &GoFlow{
+			ctx:                ctx,
+			cancel:             cancel,
+			taskBroker:         taskBroker,
+			resultsBroker:      resultsBroker,
+			results:            store.NewInMemoryKVStore[string, task.Result](),
+			statuses:           store.NewInMemoryKVStore[string, task.Status](),
+			resultsWriterWG:    &sync.WaitGroup{},
+			resultPollInterval: time.Hour,
+			hooks:              h,
+		}
+
+		_ = gf.Start()
+
+		return gf, taskBroker, resultsBroker
+	}
+
+	t.Run("Calls the hooks that match each result", func(t *testing.T) {
+		// Arrange
+		started := make(chan task.Status, 1)
+		finished := make(chan task.Result, 2)
+		failed := make(chan task.Result, 2)
+
+		gf, _, resultsBroker := newStartedGoFlow(hooks{
+			onTaskStarted: []func(task.Status){func(s task.Status) { started <- s }},
+			onResult:      []func(task.Result){func(r task.Result) { finished <- r }},
+			onFailure:     []func(task.Result){func(r task.Result) { failed <- r }},
+		})
+
+		ctx := context.Background()
+
+		// Act
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "a", State: task.StateRunning, Attempt: 1})
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "a", State: task.StatePending, Attempt: 2})
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "a", State: task.StateSucceeded, Attempt: 2})
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "b", State: task.StateFailed, ErrMsg: "boom"})
+
+		_ = gf.Close()
+
+		close(started)
+		close(finished)
+		close(failed)
+
+		// Assert
+		startedIDs := []string{}
+		for s := range started {
+			startedIDs = append(startedIDs, s.TaskID)
+		}
+
+		finishedIDs := []string{}
+		for r := range finished {
+			finishedIDs = append(finishedIDs, r.TaskID)
+		}
+
+		failedIDs := []string{}
+		for r := range failed {
+			failedIDs = append(failedIDs, r.TaskID)
+		}
+
+		assert.Equal(t, []string{"a"}, startedIDs)
+		assert.ElementsMatch(t, []string{"a", "b"}, finishedIDs)
+		assert.Equal(t, []string{"b"}, failedIDs)
+	})
+
+	t.Run("Keeps persisting results while hooks are blocked or panicking", func(t *testing.T) {
+		// Arrange
+		unblock := make(chan struct{})
+
+		gf, _, resultsBroker := newStartedGoFlow(hooks{
+			onResult: []func(task.Result){
+				func(task.Result) { <-unblock },
+				func(task.Result) { panic("hook failed") },
+			},
+		})
+
+		ctx := context.Background()
+
+		// Act
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "a", State: task.StateSucceeded})
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "b", State: task.StateSucceeded})
+
+		// Submitting the next result guarantees the previous one was persisted
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: "other"})
+
+		_, aFound, _ := gf.GetResult("a")
+		_, bFound, _ := gf.GetResult("b")
+
+		close(unblock)
+		_ = gf.Close()
+
+		// Assert
+		assert.True(t, aFound)
+		assert.True(t, bFound)
+	})
+
+	t.Run("Calls the push callback with the task's result", func(t *testing.T) {
+		// Arrange
+		gf, taskBroker, resultsBroker := newStartedGoFlow(hooks{})
+
+		results := make(chan task.Result, 1)
+
+		ctx := context.Background()
+
+		// Act
+		taskID, _ := gf.Push("exampleTask", "examplePayload", WithCallback(func(r task.Result) {
+			results <- r
+		}))
+
+		<-taskBroker.Dequeue(gf.ctx)
+
+		awaitSubscribed(t, gf, taskID)
+
+		_ = resultsBroker.Submit(ctx, task.Result{TaskID: taskID, State: task.StateSucceeded, Payload: "done"})
+
+		// Assert
+		select {
+		case result := <-results:
+			assert.Equal(t, "done", result.Payload)
+		case <-time.After(time.Second):
+			t.Fatal("callback was not called")
+		}
+
+		_ = gf.Close()
+	})
+}
+
+func Test_GoFlow_PushGroup(t *testing.T) {
+	newLocalGoFlow := func(handlers map[string]task.Handler) *GoFlow {
+		handlerStore := store.NewInMemoryKVStore[string, task.Handler]()
+		for taskType, handler := range handlers {
+			handlerStore.Put(taskType, handler)
+		}
+
+		gf := NewLocalMode(handlerStore)
+		_ = gf.Start()
+
+		return gf
+	}
+
+	t.Run("Reports how many members have finished", func(t *testing.T) {
+		// Arrange
+		gf := newLocalGoFlow(map[string]task.Handler{
+			"double": func(_ context.Context, tsk task.Task) task.Result {
+				if tsk.Payload == "bad" {
+					return task.Result{ErrMsg: "bad payload"}
+				}
+
+				return task.Result{Payload: tsk.Payload}
+			},
+		})
+		defer gf.Close()
+
+		// Act
+		groupID, err := gf.PushGroup("double", []any{"a", "bad", "c"})
+
+		status, found, _ := gf.GetGroup(groupID)
+		for result := range gf.Subscribe(status.TaskIDs...) {
+			_ = result
+		}
+
+		finished, _, _ := gf.GetGroup(groupID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Len(t, status.TaskIDs, 3)
+
+		assert.Equal(t, 3, finished.Total)
+		assert.Equal(t, 2, finished.Succeeded)
+		assert.Equal(t, 1, finished.Failed)
+		assert.True(t, finished.Done())
+	})
+
+	t.Run("Pushes the reducer with every member result once the last finishes", func(t *testing.T) {
+		// Arrange
+		gf := newLocalGoFlow(map[string]task.Handler{
+			"square": func(_ context.Context, tsk task.Task) task.Result {
+				n := tsk.Payload.(int)
+				return task.Result{Payload: n * n}
+			},
+			"sum": func(_ context.Context, tsk task.Task) task.Result {
+				total := 0
+				for _, result := range tsk.Payload.([]task.Result) {
+					total += result.Payload.(int)
+				}
+
+				return task.Result{Payload: total}
+			},
+		})
+		defer gf.Close()
+
+		// Act
+		groupID, err := gf.PushGroup("square", []any{1, 2, 3}, WithReducer("sum"))
+
+		var status GroupStatus
+
+		assert.Eventually(t, func() bool {
+			status, _, _ = gf.GetGroup(groupID)
+			return status.ReducerTaskID != ""
+		}, time.Second, time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		reduced, awaitErr := gf.Await(ctx, status.ReducerTaskID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, awaitErr)
+		assert.Equal(t, "sum", status.ReducerTaskType)
+		assert.Equal(t, 14, reduced.Payload)
+	})
+
+	t.Run("Returns false for an unknown group", func(t *testing.T) {
+		// Arrange
+		gf := newLocalGoFlow(nil)
+		defer gf.Close()
+
+		// Act
+		_, found, err := gf.GetGroup("unknown")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("Returns ErrNotStarted if GoFlow is not started", func(t *testing.T) {
+		// Arrange
+		gf := &GoFlow{}
+
+		// Act
+		_, err := gf.PushGroup("square", []any{1})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotStarted)
+	})
+}
+
+func Test_GoFlow_GetResult(t *testing.T) {
+	t.Run("Returns the result of given taskID if it exists", func(t *testing.T) {
+		// Arrange
+		mockResults := new(mockKVStore[string, task.Result])
+
+		gf := GoFlow{
+			results: mockResults,
+			started: true,
+		}
+
+		taskID := "taskID"
+
+		expectedResult := task.Result{Payload: "result"}
+
+		mockResults.On("Get", mock.Anything).Once().Return(expectedResult, true)
+
+		// Act
+		result, ok, err := gf.GetResult(taskID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, expectedResult, result)
+		assert.True(t, ok)
+	})
+
+	t.Run("Returns false if given taskID doesn't exist", func(t *testing.T) {
+		// Arrange
+		mockResults := new(mockKVStore[string, task.Result])
+
+		gf := GoFlow{
+			results: mockResults,
+			started: true,
+		}
+
+		taskID := "taskID"
+
+		expectedResult := task.Result{}
+
+		mockResults.On("Get", mock.Anything).Once().Return(expectedResult, false)
+
+		// Act
+		result, ok, err := gf.GetResult(taskID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, expectedResult, result)
+		assert.False(t, ok)
+	})
+
+	t.Run("Returns ErrNotStarted if GoFlow instance isn't started", func(t *testing.T) {
+		// Arrange
+		mockResults := new(mockKVStore[string, task.Result])
+
+		gf := GoFlow{
+			results: mockResults,
+			started: false,
+		}
+
+		taskID := "taskID"
+
+		// Act
+		result, ok, err := gf.GetResult(taskID)
+
+		// Assert
+		assert.EqualError(t, err, ErrNotStarted.Error())
+		assert.False(t, ok)
+		assert.Equal(t, task.Result{}, result)
+		mockResults.AssertExpectations(t)
+	})
+}
+
+func Test_GoFlow_Stop(t *testing.T) {
+	t.Run("Calls cancel and waits for all components to shut down", func(t *testing.T) {
+		// Arrange
+		wasCancelCalled := false
+		mockCancel := func() {
+			wasCancelCalled = true
+		}
+
+		mockWorkerPool := &mockWorkerPool{}
+		mockWorkerPool.On("AwaitShutdown").Once()
+
+		mockTaskBroker := &mockBroker[task.Task]{}
+		mockTaskBroker.On("AwaitShutdown").Once()
+
+		mockResultBroker := &mockBroker[task.Result]{}
+		mockResultBroker.On("AwaitShutdown").Once()
+
+		gf := GoFlow{
+			cancel:          mockCancel,
+			workers:         mockWorkerPool,
+			taskBroker:      mockTaskBroker,
+			resultsBroker:   mockResultBroker,
+			resultsWriterWG: &sync.WaitGroup{},
+			started:         true,
+			statuses:        store.NewInMemoryKVStore[string, task.Status](),
+		}
+
+		// Act
+		err := gf.Close()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.True(t, wasCancelCalled)
+
+		mockWorkerPool.AssertExpectations(t)
+		mockTaskBroker.AssertExpectations(t)
+		mockResultBroker.AssertExpectations(t)
+	})
+
+	t.Run("Can be called while other goroutines are pushing tasks", func(t *testing.T) {
+		// Arrange
+		gf := NewLocalMode(store.NewInMemoryKVStore[string, task.Handler](), WithNumWorkers(1))
+		_ = gf.Start()
+
+		pushing := make(chan error)
+
+		go func() {
+			for {
+				if _, err := gf.Push("exampleTask", "examplePayload"); err != nil {
+					pushing <- err
+					return
+				}
+			}
+		}()
+
+		// Act
+		err := gf.Close()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Error(t, <-pushing)
+	})
+
+	t.Run("Returns ErrNotStarted if GoFlow instance not started", func(t *testing.T) {
+		// Arrange
+		wasCancelCalled := false
+		mockCancel := func() {
+			wasCancelCalled = true
+		}
+
+		mockWorkerPool := &mockWorkerPool{}
+		mockTaskBroker := &mockBroker[task.Task]{}
+		mockResultBroker := &mockBroker[task.Result]{}
+
+		gf := GoFlow{
+			cancel:          mockCancel,
+			workers:         mockWorkerPool,
+			taskBroker:      mockTaskBroker,
+			resultsBroker:   mockResultBroker,
+			resultsWriterWG: &sync.WaitGroup{},
+			started:         false,
+			statuses:        store.NewInMemoryKVStore[string, task.Status](),
+		}
+
+		// Act
+		err := gf.Close()
+
+		// Assert
+		assert.EqualError(t, err, ErrNotStarted.Error())
+		assert.False(t, wasCancelCalled)
+		assert.False(t, gf.started)
+
+		mockWorkerPool.AssertExpectations(t)
+		mockTaskBroker.AssertExpectations(t)
+		mockResultBroker.AssertExpectations(t)
+	})
+}
+
+// awaitSubscribed waits until something has subscribed to the result of the task.
+func awaitSubscribed(t *testing.T, gf *GoFlow, taskID string) {
+	t.Helper()
+
+	assert.Eventually(t, func() bool {
+		gf.subscriptions.mu.Lock()
+		defer gf.subscriptions.mu.Unlock()
+
+		return len(gf.subscriptions.byTask[taskID]) > 0
+	}, time.Second, time.Millisecond)
+}
+
+type mockWorkerPool struct {
+	mock.Mock
+}
+
+func (m *mockWorkerPool) Start(
+	ctx context.Context,
+	taskQueue workerpool.TaskQueue,
+	results task.Submitter[task.Result],
+	taskHandlers workerpool.HandlerGetter,
+) {
+	m.Called(ctx, taskQueue, results, taskHandlers)
+}
+
+func (m *mockWorkerPool) AwaitShutdown() {
+	m.Called()
+}
+
+type mockBroker[T any] struct {
+	mock.Mock
+}
+
+func (m *mockBroker[T]) Submit(ctx context.Context, tsk T) error {
+	args := m.Called(ctx, tsk)
+	return args.Error(0)
+}
+
+func (m *mockBroker[T]) Dequeue(ctx context.Context) <-chan T {
+	args := m.Called(ctx)
+	return args.Get(0).(<-chan T)
+}
+
+func (m *mockBroker[T]) AwaitShutdown() {
+	m.Called()
+}
+
+type mockDelayedBroker[T any] struct {
+	mockBroker[T]
+}
+
+func (m *mockDelayedBroker[T]) SubmitAt(ctx context.Context, tsk T, at time.Time) error {
+	args := m.Called(ctx, tsk, at)
+	return args.Error(0)
+}
+
+type mockBatchBroker[T any] struct {
+	mockBroker[T]
+}
+
+func (m *mockBatchBroker[T]) SubmitBatch(ctx context.Context, batch []T) error {
+	args := m.Called(ctx, batch)
+	return args.Error(0)
+}
+
+type mockIdempotencyStore struct {
+	mock.Mock
+}
+
+func (m *mockIdempotencyStore) Claim(ctx context.Context, key, taskID string, window time.Duration) (string, bool, error) {
+	args := m.Called(ctx, key, taskID, window)
+	return args.String(0), args.Bool(1), args.Error(2)
+}
+
+func (m *mockIdempotencyStore) Release(ctx context.Context, key, taskID string) error {
+	args := m.Called(ctx, key, taskID)
+	return args.Error(0)
+}
+
+type mockKVStore[K comparable, V any] struct {
+	mock.Mock
+}
+
+func (m *mockKVStore[K, V]) Put(key K, value V) {
+	m.Called(key, value)
+}
+
+func (m *mockKVStore[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	m.Called(key, value, ttl)
+}
+
+func (m *mockKVStore[K, V]) Get(key K) (V, bool) {
+	args := m.Called(key)
+	return args.Get(0).(V), args.Bool(1)
+}
+
+func (m *mockKVStore[K, V]) Delete(key K) {
+	m.Called(key)
 }
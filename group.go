@@ -0,0 +1,191 @@
+package goflow
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+// Group is a set of tasks pushed together with PushGroup.
+type Group struct {
+	ID      string
+	TaskIDs []string
+
+	// ReducerTaskType is the type of the task pushed once every member has
+	// finished, if any.
+	ReducerTaskType string
+
+	// ReducerTaskID is the ID of the reducer task, once it has been pushed.
+	ReducerTaskID string
+}
+
+// GroupStatus summarises the progress of a group's members.
+type GroupStatus struct {
+	Group
+
+	Total     int
+	Succeeded int
+	Failed    int
+	Cancelled int
+}
+
+// Done reports whether every member of the group has finished.
+func (s GroupStatus) Done() bool {
+	return s.Succeeded+s.Failed+s.Cancelled == s.Total
+}
+
+// A GroupOption configures a group submitted with PushGroup.
+type GroupOption interface {
+	apply(*groupOptions)
+}
+
+type groupOptions struct {
+	memberOpts      []PushOption
+	reducerTaskType string
+	reducerOpts     []PushOption
+}
+
+type memberOptionsOption struct {
+	Opts []PushOption
+}
+
+func (m memberOptionsOption) apply(opts *groupOptions) {
+	opts.memberOpts = append(opts.memberOpts, m.Opts...)
+}
+
+// WithMemberOptions applies the push options to every member of the group.
+func WithMemberOptions(opts ...PushOption) GroupOption {
+	return memberOptionsOption{Opts: opts}
+}
+
+type reducerOption struct {
+	TaskType string
+	Opts     []PushOption
+}
+
+func (r reducerOption) apply(opts *groupOptions) {
+	opts.reducerTaskType = r.TaskType
+	opts.reducerOpts = r.Opts
+}
+
+// WithReducer pushes a task of the given type once every member of the group has
+// finished. Its payload is a []task.Result holding the members' results in the
+// order their payloads were given, including those that failed. The push options
+// apply to the reducer task.
+func WithReducer(taskType string, opts ...PushOption) GroupOption {
+	return reducerOption{TaskType: taskType, Opts: opts}
+}
+
+// PushGroup pushes a task of the given type for each payload and returns the ID of
+// the group they belong to. GetGroup reports how many of them have finished.
+//
+// The members are pushed with PushBatch. If that fails, PushGroup returns the error
+// without recording the group, and any members that were already pushed still run.
+func (gf *GoFlow) PushGroup(taskType string, payloads []any, opts ...GroupOption) (string, error) {
+	if !gf.isStarted() {
+		return "", ErrNotStarted
+	}
+
+	groupOpts := groupOptions{}
+
+	for _, o := range opts {
+		o.apply(&groupOpts)
+	}
+
+	group := Group{
+		ID:              uuid.New().String(),
+		ReducerTaskType: groupOpts.reducerTaskType,
+	}
+
+	requests := make([]PushRequest, 0, len(payloads))
+	for _, payload := range payloads {
+		requests = append(requests, PushRequest{TaskType: taskType, Payload: payload, Opts: groupOpts.memberOpts})
+	}
+
+	taskIDs, err := gf.PushBatch(requests)
+	if err != nil {
+		return "", fmt.Errorf("failed to push group members: %w", err)
+	}
+
+	group.TaskIDs = taskIDs
+
+	putWithResultTTL(gf, gf.groups, group.ID, group)
+
+	if group.ReducerTaskType != "" {
+		gf.watchGroup(group, groupOpts.reducerOpts)
+	}
+
+	return group.ID, nil
+}
+
+// GetGroup retrieves the status of the group with the specified ID, counting its
+// finished members from the results store. It returns the status and a boolean
+// indicating whether the group was found.
+func (gf *GoFlow) GetGroup(groupID string) (GroupStatus, bool, error) {
+	if !gf.isStarted() {
+		return GroupStatus{}, false, ErrNotStarted
+	}
+
+	group, ok := gf.groups.Get(groupID)
+	if !ok {
+		return GroupStatus{}, false, nil
+	}
+
+	status := GroupStatus{Group: group, Total: len(group.TaskIDs)}
+
+	for _, taskID := range group.TaskIDs {
+		result, ok := gf.results.Get(taskID)
+		if !ok {
+			continue
+		}
+
+		switch result.Status().State {
+		case task.StateFailed:
+			status.Failed++
+		case task.StateCancelled:
+			status.Cancelled++
+		default:
+			status.Succeeded++
+		}
+	}
+
+	return status, true, nil
+}
+
+// watchGroup pushes the group's reducer once every member has a result. If GoFlow
+// is closed first, the reducer is not pushed.
+func (gf *GoFlow) watchGroup(group Group, reducerOpts []PushOption) {
+	results := gf.Subscribe(group.TaskIDs...)
+
+	gf.subscribersWG.Add(1)
+
+	go func() {
+		defer gf.subscribersWG.Done()
+
+		byTask := make(map[string]task.Result, len(group.TaskIDs))
+		for result := range results {
+			byTask[result.TaskID] = result
+		}
+
+		if len(byTask) < len(group.TaskIDs) {
+			return
+		}
+
+		memberResults := make([]task.Result, 0, len(group.TaskIDs))
+		for _, taskID := range group.TaskIDs {
+			memberResults = append(memberResults, byTask[taskID])
+		}
+
+		reducerID, err := gf.Push(group.ReducerTaskType, memberResults, reducerOpts...)
+		if err != nil {
+			log.Printf("failed to push reducer for group %s: %v", group.ID, err)
+
+			return
+		}
+
+		group.ReducerTaskID = reducerID
+		putWithResultTTL(gf, gf.groups, group.ID, group)
+	}()
+}
@@ -3,10 +3,12 @@ package client
 import (
 	"context"
 	"fmt"
+	"time"
 
 	pb "github.com/jamesTait-jt/goflow/grpc/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type GoFlowGRPCClient struct {
@@ -32,11 +34,17 @@ func NewGoFlowClient(connString string, opt ...GoFlowGRPCClientOption) (*GoFlowG
 	}, nil
 }
 
-func (g *GoFlowGRPCClient) Push(taskType, payload string) (string, error) {
+func (g *GoFlowGRPCClient) Push(taskType, payload string, opts ...PushOption) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), g.opts.requestTimeout)
 	defer cancel()
 
-	r, err := g.client.PushTask(ctx, &pb.PushTaskRequest{TaskType: taskType, Payload: payload})
+	req := &pb.PushTaskRequest{TaskType: taskType, Payload: payload}
+
+	for _, o := range opts {
+		o.apply(req)
+	}
+
+	r, err := g.client.PushTask(ctx, req)
 	if err != nil {
 		return "", fmt.Errorf("failed to push task: %w", err)
 	}
@@ -44,6 +52,39 @@ func (g *GoFlowGRPCClient) Push(taskType, payload string) (string, error) {
 	return r.GetId(), nil
 }
 
+// PushRequest describes a single task pushed with PushBatch.
+type PushRequest struct {
+	TaskType string
+	Payload  string
+	Opts     []PushOption
+}
+
+// PushBatch pushes every task in a single request and returns their IDs, in the
+// same order as the requests.
+func (g *GoFlowGRPCClient) PushBatch(requests []PushRequest) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.opts.requestTimeout)
+	defer cancel()
+
+	req := &pb.PushTasksRequest{Tasks: make([]*pb.PushTaskRequest, 0, len(requests))}
+
+	for _, r := range requests {
+		t := &pb.PushTaskRequest{TaskType: r.TaskType, Payload: r.Payload}
+
+		for _, o := range r.Opts {
+			o.apply(t)
+		}
+
+		req.Tasks = append(req.Tasks, t)
+	}
+
+	r, err := g.client.PushTasks(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to push %d tasks: %w", len(requests), err)
+	}
+
+	return r.GetIds(), nil
+}
+
 func (g *GoFlowGRPCClient) Get(taskID string) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), g.opts.requestTimeout)
 	defer cancel()
@@ -60,3 +101,176 @@ func (g *GoFlowGRPCClient) Get(taskID string) (string, error) {
 		return r.GetErrMsg(), nil
 	}
 }
+
+// Wait blocks until the task has finished and returns its result, formatted as by
+// Get. Unlike the other methods it is not bound by the request timeout, so the
+// caller should set a deadline on ctx if it does not want to wait indefinitely.
+func (g *GoFlowGRPCClient) Wait(ctx context.Context, taskID string) (string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := g.client.WatchResult(ctx, &pb.WatchResultRequest{TaskIDs: []string{taskID}})
+	if err != nil {
+		return "", fmt.Errorf("could not watch result for taskID '%s': %w", taskID, err)
+	}
+
+	r, err := stream.Recv()
+	if err != nil {
+		return "", fmt.Errorf("could not wait for result for taskID '%s': %w", taskID, err)
+	}
+
+	switch r.GetErrMsg() {
+	case "":
+		return r.GetResult(), nil
+	default:
+		return r.GetErrMsg(), nil
+	}
+}
+
+func (g *GoFlowGRPCClient) GetStatus(taskID string) (*pb.GetStatusReply, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.opts.requestTimeout)
+	defer cancel()
+
+	r, err := g.client.GetStatus(ctx, &pb.GetStatusRequest{TaskID: taskID})
+	if err != nil {
+		return nil, fmt.Errorf("could not get status for taskID '%s': %w", taskID, err)
+	}
+
+	return r, nil
+}
+
+// Cancel cancels the task with the given ID. A task that has not started yet will
+// not run, and a running task has its context cancelled.
+func (g *GoFlowGRPCClient) Cancel(taskID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), g.opts.requestTimeout)
+	defer cancel()
+
+	_, err := g.client.CancelTask(ctx, &pb.CancelTaskRequest{TaskID: taskID})
+	if err != nil {
+		return fmt.Errorf("failed to cancel task '%s': %w", taskID, err)
+	}
+
+	return nil
+}
+
+func (g *GoFlowGRPCClient) AddSchedule(taskType, payload, spec string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.opts.requestTimeout)
+	defer cancel()
+
+	r, err := g.client.AddSchedule(ctx, &pb.AddScheduleRequest{TaskType: taskType, Payload: payload, Spec: spec})
+	if err != nil {
+		return "", fmt.Errorf("failed to add schedule: %w", err)
+	}
+
+	return r.GetId(), nil
+}
+
+func (g *GoFlowGRPCClient) RemoveSchedule(scheduleID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), g.opts.requestTimeout)
+	defer cancel()
+
+	_, err := g.client.RemoveSchedule(ctx, &pb.RemoveScheduleRequest{Id: scheduleID})
+	if err != nil {
+		return fmt.Errorf("failed to remove schedule '%s': %w", scheduleID, err)
+	}
+
+	return nil
+}
+
+func (g *GoFlowGRPCClient) ListSchedules() ([]*pb.Schedule, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.opts.requestTimeout)
+	defer cancel()
+
+	r, err := g.client.ListSchedules(ctx, &pb.ListSchedulesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	return r.GetSchedules(), nil
+}
+
+func (g *GoFlowGRPCClient) ListDeadLetters() ([]*pb.DeadLetter, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.opts.requestTimeout)
+	defer cancel()
+
+	r, err := g.client.ListDeadLetters(ctx, &pb.ListDeadLettersRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead letters: %w", err)
+	}
+
+	return r.GetDeadLetters(), nil
+}
+
+func (g *GoFlowGRPCClient) ReplayDeadLetter(taskID string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.opts.requestTimeout)
+	defer cancel()
+
+	r, err := g.client.ReplayDeadLetter(ctx, &pb.ReplayDeadLetterRequest{TaskID: taskID})
+	if err != nil {
+		return "", fmt.Errorf("failed to replay dead letter '%s': %w", taskID, err)
+	}
+
+	return r.GetId(), nil
+}
+
+func (g *GoFlowGRPCClient) PurgeDeadLetters() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.opts.requestTimeout)
+	defer cancel()
+
+	r, err := g.client.PurgeDeadLetters(ctx, &pb.PurgeDeadLettersRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge dead letters: %w", err)
+	}
+
+	return int(r.GetPurged()), nil
+}
+
+// ResultFilter selects the results returned by ListResults. Fields left at their zero
+// value match every result.
+type ResultFilter struct {
+	TaskType string
+
+	// State is the state the task finished in: succeeded, failed or cancelled.
+	State string
+
+	// FinishedAfter and FinishedBefore bound when the task finished. FinishedAfter is
+	// inclusive and FinishedBefore exclusive.
+	FinishedAfter  time.Time
+	FinishedBefore time.Time
+
+	// Metadata matches results whose metadata holds every one of its keys with the
+	// same value.
+	Metadata map[string]string
+}
+
+// ListResults returns a page of the stored results that match the filter, and the
+// cursor for the next page. Pass an empty cursor for the first page; the cursor
+// returned with the last page is empty. Only the last page holds fewer than limit
+// results, and a limit of 0 uses the server's default.
+func (g *GoFlowGRPCClient) ListResults(filter ResultFilter, cursor string, limit int) ([]*pb.TaskResult, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.opts.requestTimeout)
+	defer cancel()
+
+	req := &pb.ListResultsRequest{
+		TaskType: filter.TaskType,
+		State:    filter.State,
+		Cursor:   cursor,
+		Limit:    int32(limit), // nolint:gosec // capped by the server
+		Metadata: filter.Metadata,
+	}
+
+	if !filter.FinishedAfter.IsZero() {
+		req.FinishedAfter = timestamppb.New(filter.FinishedAfter)
+	}
+
+	if !filter.FinishedBefore.IsZero() {
+		req.FinishedBefore = timestamppb.New(filter.FinishedBefore)
+	}
+
+	r, err := g.client.ListResults(ctx, req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list results: %w", err)
+	}
+
+	return r.GetResults(), r.GetNextCursor(), nil
+}
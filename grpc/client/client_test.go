@@ -5,6 +5,7 @@ package client
 import (
 	"context"
 	"errors"
+	"io"
 	"testing"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_GoFlowService_Push(t *testing.T) {
@@ -41,6 +44,53 @@ func Test_GoFlowService_Push(t *testing.T) {
 		mockClient.AssertExpectations(t)
 	})
 
+	t.Run("Sets the optional fields of the push request", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+
+		opts := goFlowGRPCClientOptions{
+			requestTimeout: time.Second,
+		}
+		service := &GoFlowGRPCClient{opts, mockClient}
+
+		taskType := "example-task"
+		payload := "example-payload"
+		expectedID := "12345"
+		runAt := time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC)
+
+		expectedReq := &pb.PushTaskRequest{
+			TaskType: taskType,
+			Payload:  payload,
+			Timeout:  durationpb.New(time.Minute),
+			RunAt:    timestamppb.New(runAt),
+			Priority: 3,
+			Metadata: map[string]string{"trace_id": "abc", "tenant": "other"},
+
+			IdempotencyKey: "key",
+		}
+
+		mockClient.On("PushTask", mock.Anything, expectedReq).
+			Once().
+			Return(&pb.PushTaskReply{Id: expectedID}, nil)
+
+		// Act
+		taskID, err := service.Push(
+			taskType,
+			payload,
+			WithTaskTimeout(time.Minute),
+			WithRunAt(runAt),
+			WithPriority(3),
+			WithIdempotencyKey("key"),
+			WithMetadata(map[string]string{"trace_id": "abc", "tenant": "acme"}),
+			WithMetadata(map[string]string{"tenant": "other"}),
+		)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expectedID, taskID)
+		mockClient.AssertExpectations(t)
+	})
+
 	t.Run("Returns error if push task fails", func(t *testing.T) {
 		// Arrange
 		mockClient := new(mockGoFlowClient)
@@ -95,6 +145,49 @@ func Test_GoFlowService_Push(t *testing.T) {
 	})
 }
 
+func Test_GoFlowGRPCClient_PushBatch(t *testing.T) {
+	t.Run("Pushes every task in one request", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		expectedReq := &pb.PushTasksRequest{Tasks: []*pb.PushTaskRequest{
+			{TaskType: "resize", Payload: "a"},
+			{TaskType: "index", Payload: "b", Priority: 5},
+		}}
+		mockClient.On("PushTasks", mock.Anything, expectedReq).
+			Once().
+			Return(&pb.PushTasksReply{Ids: []string{"id-1", "id-2"}}, nil)
+
+		// Act
+		ids, err := service.PushBatch([]PushRequest{
+			{TaskType: "resize", Payload: "a"},
+			{TaskType: "index", Payload: "b", Opts: []PushOption{WithPriority(5)}},
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"id-1", "id-2"}, ids)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Returns an error if the request fails", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		pushErr := errors.New("unavailable")
+		mockClient.On("PushTasks", mock.Anything, mock.Anything).Once().Return(nil, pushErr)
+
+		// Act
+		ids, err := service.PushBatch([]PushRequest{{TaskType: "resize"}})
+
+		// Assert
+		assert.ErrorIs(t, err, pushErr)
+		assert.Nil(t, ids)
+	})
+}
+
 func Test_GoFlowService_Get(t *testing.T) {
 	t.Run("Successfully retrieves task result", func(t *testing.T) {
 		// Arrange
@@ -222,6 +315,330 @@ func Test_GoFlowService_Get(t *testing.T) {
 	})
 }
 
+func Test_GoFlowGRPCClient_Wait(t *testing.T) {
+	t.Run("Returns the first result on the stream", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Millisecond}, mockClient}
+
+		stream := &mockWatchResultStream{replies: []*pb.WatchResultReply{{TaskID: "task-id", Result: "done"}}}
+		mockClient.On("WatchResult", mock.Anything, &pb.WatchResultRequest{TaskIDs: []string{"task-id"}}).
+			Once().
+			Return(stream, nil)
+
+		// Act
+		result, err := service.Wait(context.Background(), "task-id")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "done", result)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Returns the error message if the task failed", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Millisecond}, mockClient}
+
+		stream := &mockWatchResultStream{replies: []*pb.WatchResultReply{{TaskID: "task-id", ErrMsg: "failed"}}}
+		mockClient.On("WatchResult", mock.Anything, mock.Anything).Once().Return(stream, nil)
+
+		// Act
+		result, err := service.Wait(context.Background(), "task-id")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "failed", result)
+	})
+
+	t.Run("Returns an error if the stream ends without a result", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Millisecond}, mockClient}
+
+		mockClient.On("WatchResult", mock.Anything, mock.Anything).Once().Return(&mockWatchResultStream{}, nil)
+
+		// Act
+		_, err := service.Wait(context.Background(), "task-id")
+
+		// Assert
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("Returns an error if the stream cannot be opened", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Millisecond}, mockClient}
+
+		watchErr := errors.New("unavailable")
+		mockClient.On("WatchResult", mock.Anything, mock.Anything).Once().Return(nil, watchErr)
+
+		// Act
+		_, err := service.Wait(context.Background(), "task-id")
+
+		// Assert
+		assert.ErrorIs(t, err, watchErr)
+	})
+}
+
+func Test_GoFlowGRPCClient_GetStatus(t *testing.T) {
+	t.Run("Returns the status of the task", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		reply := &pb.GetStatusReply{State: "running", Attempt: 1}
+		mockClient.On("GetStatus", mock.Anything, &pb.GetStatusRequest{TaskID: "task-id"}).
+			Once().
+			Return(reply, nil)
+
+		// Act
+		status, err := service.GetStatus("task-id")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, reply, status)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Returns an error if getting the status fails", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		statusErr := errors.New("not found")
+		mockClient.On("GetStatus", mock.Anything, &pb.GetStatusRequest{TaskID: "task-id"}).
+			Once().
+			Return(nil, statusErr)
+
+		// Act
+		status, err := service.GetStatus("task-id")
+
+		// Assert
+		assert.ErrorIs(t, err, statusErr)
+		assert.Nil(t, status)
+	})
+}
+
+func Test_GoFlowGRPCClient_Schedules(t *testing.T) {
+	t.Run("Adds a schedule and returns its ID", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		expectedReq := &pb.AddScheduleRequest{TaskType: "report", Payload: "payload", Spec: "@daily"}
+		mockClient.On("AddSchedule", mock.Anything, expectedReq).
+			Once().
+			Return(&pb.AddScheduleReply{Id: "schedule-id"}, nil)
+
+		// Act
+		scheduleID, err := service.AddSchedule("report", "payload", "@daily")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "schedule-id", scheduleID)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Returns an error if removing the schedule fails", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		removeErr := errors.New("not found")
+		mockClient.On("RemoveSchedule", mock.Anything, &pb.RemoveScheduleRequest{Id: "schedule-id"}).
+			Once().
+			Return(nil, removeErr)
+
+		// Act
+		err := service.RemoveSchedule("schedule-id")
+
+		// Assert
+		assert.ErrorIs(t, err, removeErr)
+		assert.Contains(t, err.Error(), "failed to remove schedule 'schedule-id'")
+	})
+
+	t.Run("Lists the schedules", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		schedules := []*pb.Schedule{{Id: "a"}, {Id: "b"}}
+		mockClient.On("ListSchedules", mock.Anything, &pb.ListSchedulesRequest{}).
+			Once().
+			Return(&pb.ListSchedulesReply{Schedules: schedules}, nil)
+
+		// Act
+		listed, err := service.ListSchedules()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, schedules, listed)
+	})
+}
+
+func Test_GoFlowGRPCClient_Cancel(t *testing.T) {
+	t.Run("Cancels the task", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		mockClient.On("CancelTask", mock.Anything, &pb.CancelTaskRequest{TaskID: "task-id"}).
+			Once().
+			Return(&pb.CancelTaskReply{}, nil)
+
+		// Act
+		err := service.Cancel("task-id")
+
+		// Assert
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Wraps the error if cancelling fails", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		cancelErr := errors.New("not found")
+		mockClient.On("CancelTask", mock.Anything, &pb.CancelTaskRequest{TaskID: "task-id"}).
+			Once().
+			Return(nil, cancelErr)
+
+		// Act
+		err := service.Cancel("task-id")
+
+		// Assert
+		assert.ErrorIs(t, err, cancelErr)
+		assert.Contains(t, err.Error(), "failed to cancel task 'task-id'")
+	})
+}
+
+func Test_GoFlowGRPCClient_DeadLetters(t *testing.T) {
+	t.Run("Lists the dead letters", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		deadLetters := []*pb.DeadLetter{{TaskID: "a"}, {TaskID: "b"}}
+		mockClient.On("ListDeadLetters", mock.Anything, &pb.ListDeadLettersRequest{}).
+			Once().
+			Return(&pb.ListDeadLettersReply{DeadLetters: deadLetters}, nil)
+
+		// Act
+		listed, err := service.ListDeadLetters()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, deadLetters, listed)
+	})
+
+	t.Run("Replays a dead letter", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		mockClient.On("ReplayDeadLetter", mock.Anything, &pb.ReplayDeadLetterRequest{TaskID: "task-id"}).
+			Once().
+			Return(&pb.ReplayDeadLetterReply{Id: "replayed-id"}, nil)
+
+		// Act
+		replayedID, err := service.ReplayDeadLetter("task-id")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "replayed-id", replayedID)
+	})
+
+	t.Run("Wraps the error if replaying fails", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		replayErr := errors.New("not found")
+		mockClient.On("ReplayDeadLetter", mock.Anything, &pb.ReplayDeadLetterRequest{TaskID: "task-id"}).
+			Once().
+			Return(nil, replayErr)
+
+		// Act
+		_, err := service.ReplayDeadLetter("task-id")
+
+		// Assert
+		assert.ErrorIs(t, err, replayErr)
+		assert.Contains(t, err.Error(), "failed to replay dead letter 'task-id'")
+	})
+
+	t.Run("Purges the dead letters", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		mockClient.On("PurgeDeadLetters", mock.Anything, &pb.PurgeDeadLettersRequest{}).
+			Once().
+			Return(&pb.PurgeDeadLettersReply{Purged: 2}, nil)
+
+		// Act
+		purged, err := service.PurgeDeadLetters()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, purged)
+	})
+}
+
+func Test_GoFlowGRPCClient_ListResults(t *testing.T) {
+	t.Run("Sends the filter and returns the page of results", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		finishedAfter := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		results := []*pb.TaskResult{{TaskID: "a"}, {TaskID: "b"}}
+
+		mockClient.On("ListResults", mock.Anything, &pb.ListResultsRequest{
+			TaskType:      "resize",
+			State:         "failed",
+			FinishedAfter: timestamppb.New(finishedAfter),
+			Cursor:        "cursor",
+			Limit:         10,
+			Metadata:      map[string]string{"tenant": "acme"},
+		}).Once().Return(&pb.ListResultsReply{Results: results, NextCursor: "next"}, nil)
+
+		// Act
+		listed, next, err := service.ListResults(
+			ResultFilter{
+				TaskType:      "resize",
+				State:         "failed",
+				FinishedAfter: finishedAfter,
+				Metadata:      map[string]string{"tenant": "acme"},
+			},
+			"cursor",
+			10,
+		)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, results, listed)
+		assert.Equal(t, "next", next)
+	})
+
+	t.Run("Wraps the error if listing fails", func(t *testing.T) {
+		// Arrange
+		mockClient := new(mockGoFlowClient)
+		service := &GoFlowGRPCClient{goFlowGRPCClientOptions{requestTimeout: time.Second}, mockClient}
+
+		listErr := errors.New("unimplemented")
+		mockClient.On("ListResults", mock.Anything, &pb.ListResultsRequest{}).Once().Return(nil, listErr)
+
+		// Act
+		_, _, err := service.ListResults(ResultFilter{}, "", 0)
+
+		// Assert
+		assert.ErrorIs(t, err, listErr)
+		assert.Contains(t, err.Error(), "failed to list results")
+	})
+}
+
 type mockGoFlowClient struct {
 	mock.Mock
 }
@@ -235,6 +652,15 @@ func (m *mockGoFlowClient) PushTask(ctx context.Context, req *pb.PushTaskRequest
 	return args.Get(0).(*pb.PushTaskReply), args.Error(1)
 }
 
+func (m *mockGoFlowClient) PushTasks(ctx context.Context, req *pb.PushTasksRequest, _ ...grpc.CallOption) (*pb.PushTasksReply, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*pb.PushTasksReply), args.Error(1)
+}
+
 func (m *mockGoFlowClient) GetResult(ctx context.Context, req *pb.GetResultRequest, _ ...grpc.CallOption) (*pb.GetResultReply, error) {
 	args := m.Called(ctx, req)
 	if args.Get(0) == nil {
@@ -243,3 +669,145 @@ func (m *mockGoFlowClient) GetResult(ctx context.Context, req *pb.GetResultReque
 
 	return args.Get(0).(*pb.GetResultReply), args.Error(1)
 }
+
+func (m *mockGoFlowClient) GetStatus(ctx context.Context, req *pb.GetStatusRequest, _ ...grpc.CallOption) (*pb.GetStatusReply, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*pb.GetStatusReply), args.Error(1)
+}
+
+func (m *mockGoFlowClient) WatchResult(
+	ctx context.Context,
+	req *pb.WatchResultRequest,
+	_ ...grpc.CallOption,
+) (pb.GoFlow_WatchResultClient, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(pb.GoFlow_WatchResultClient), args.Error(1)
+}
+
+func (m *mockGoFlowClient) AddSchedule(
+	ctx context.Context,
+	req *pb.AddScheduleRequest,
+	_ ...grpc.CallOption,
+) (*pb.AddScheduleReply, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*pb.AddScheduleReply), args.Error(1)
+}
+
+func (m *mockGoFlowClient) CancelTask(
+	ctx context.Context,
+	req *pb.CancelTaskRequest,
+	_ ...grpc.CallOption,
+) (*pb.CancelTaskReply, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*pb.CancelTaskReply), args.Error(1)
+}
+
+func (m *mockGoFlowClient) RemoveSchedule(
+	ctx context.Context,
+	req *pb.RemoveScheduleRequest,
+	_ ...grpc.CallOption,
+) (*pb.RemoveScheduleReply, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*pb.RemoveScheduleReply), args.Error(1)
+}
+
+func (m *mockGoFlowClient) ListSchedules(
+	ctx context.Context,
+	req *pb.ListSchedulesRequest,
+	_ ...grpc.CallOption,
+) (*pb.ListSchedulesReply, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*pb.ListSchedulesReply), args.Error(1)
+}
+
+func (m *mockGoFlowClient) ListDeadLetters(
+	ctx context.Context,
+	req *pb.ListDeadLettersRequest,
+	_ ...grpc.CallOption,
+) (*pb.ListDeadLettersReply, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*pb.ListDeadLettersReply), args.Error(1)
+}
+
+func (m *mockGoFlowClient) ReplayDeadLetter(
+	ctx context.Context,
+	req *pb.ReplayDeadLetterRequest,
+	_ ...grpc.CallOption,
+) (*pb.ReplayDeadLetterReply, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*pb.ReplayDeadLetterReply), args.Error(1)
+}
+
+func (m *mockGoFlowClient) PurgeDeadLetters(
+	ctx context.Context,
+	req *pb.PurgeDeadLettersRequest,
+	_ ...grpc.CallOption,
+) (*pb.PurgeDeadLettersReply, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*pb.PurgeDeadLettersReply), args.Error(1)
+}
+
+func (m *mockGoFlowClient) ListResults(
+	ctx context.Context,
+	req *pb.ListResultsRequest,
+	_ ...grpc.CallOption,
+) (*pb.ListResultsReply, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*pb.ListResultsReply), args.Error(1)
+}
+
+type mockWatchResultStream struct {
+	grpc.ClientStream
+	replies []*pb.WatchResultReply
+}
+
+func (m *mockWatchResultStream) Recv() (*pb.WatchResultReply, error) {
+	if len(m.replies) == 0 {
+		return nil, io.EOF
+	}
+
+	reply := m.replies[0]
+	m.replies = m.replies[1:]
+
+	return reply, nil
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"maps"
+	"time"
+
+	pb "github.com/jamesTait-jt/goflow/grpc/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// A PushOption sets optional fields on a task pushed with Push.
+type PushOption interface {
+	apply(*pb.PushTaskRequest)
+}
+
+type taskTimeoutOption struct {
+	Timeout time.Duration
+}
+
+func (t taskTimeoutOption) apply(req *pb.PushTaskRequest) {
+	req.Timeout = durationpb.New(t.Timeout)
+}
+
+// WithTaskTimeout sets the maximum time the task's handler may run for.
+func WithTaskTimeout(timeout time.Duration) PushOption {
+	return taskTimeoutOption{Timeout: timeout}
+}
+
+type runAtOption struct {
+	RunAt time.Time
+}
+
+func (r runAtOption) apply(req *pb.PushTaskRequest) {
+	req.RunAt = timestamppb.New(r.RunAt)
+}
+
+// WithRunAt delays the task so that it is not run before the given time.
+func WithRunAt(at time.Time) PushOption {
+	return runAtOption{RunAt: at}
+}
+
+// WithDelay delays the task so that it is not run until the given duration
+// has passed. The delay is measured from when the option is created.
+func WithDelay(delay time.Duration) PushOption {
+	return runAtOption{RunAt: time.Now().Add(delay)}
+}
+
+type priorityOption struct {
+	Priority int
+}
+
+func (p priorityOption) apply(req *pb.PushTaskRequest) {
+	req.Priority = int32(p.Priority) // nolint:gosec // validated by the server
+}
+
+// WithPriority sets the task's priority. Higher priority tasks are dequeued first.
+func WithPriority(priority int) PushOption {
+	return priorityOption{Priority: priority}
+}
+
+type idempotencyKeyOption struct {
+	Key string
+}
+
+func (i idempotencyKeyOption) apply(req *pb.PushTaskRequest) {
+	req.IdempotencyKey = i.Key
+}
+
+// WithIdempotencyKey makes the push safe to repeat: if a task was already pushed
+// with the same key within the server's idempotency window, its ID is returned and
+// no new task is created.
+func WithIdempotencyKey(key string) PushOption {
+	return idempotencyKeyOption{Key: key}
+}
+
+type metadataOption struct {
+	Metadata map[string]string
+}
+
+func (m metadataOption) apply(req *pb.PushTaskRequest) {
+	if req.Metadata == nil {
+		req.Metadata = make(map[string]string, len(m.Metadata))
+	}
+
+	maps.Copy(req.Metadata, m.Metadata)
+}
+
+// WithMetadata attaches metadata to the task, such as a trace ID or tenant, which is
+// passed to its handler and copied onto its result. When given more than once the
+// maps are merged, later values winning.
+func WithMetadata(metadata map[string]string) PushOption {
+	return metadataOption{Metadata: metadata}
+}
@@ -19,8 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GoFlow_PushTask_FullMethodName  = "/goflow.GoFlow/PushTask"
-	GoFlow_GetResult_FullMethodName = "/goflow.GoFlow/GetResult"
+	GoFlow_PushTask_FullMethodName         = "/goflow.GoFlow/PushTask"
+	GoFlow_PushTasks_FullMethodName        = "/goflow.GoFlow/PushTasks"
+	GoFlow_GetResult_FullMethodName        = "/goflow.GoFlow/GetResult"
+	GoFlow_GetStatus_FullMethodName        = "/goflow.GoFlow/GetStatus"
+	GoFlow_WatchResult_FullMethodName      = "/goflow.GoFlow/WatchResult"
+	GoFlow_CancelTask_FullMethodName       = "/goflow.GoFlow/CancelTask"
+	GoFlow_AddSchedule_FullMethodName      = "/goflow.GoFlow/AddSchedule"
+	GoFlow_RemoveSchedule_FullMethodName   = "/goflow.GoFlow/RemoveSchedule"
+	GoFlow_ListSchedules_FullMethodName    = "/goflow.GoFlow/ListSchedules"
+	GoFlow_ListDeadLetters_FullMethodName  = "/goflow.GoFlow/ListDeadLetters"
+	GoFlow_ReplayDeadLetter_FullMethodName = "/goflow.GoFlow/ReplayDeadLetter"
+	GoFlow_PurgeDeadLetters_FullMethodName = "/goflow.GoFlow/PurgeDeadLetters"
+	GoFlow_ListResults_FullMethodName      = "/goflow.GoFlow/ListResults"
 )
 
 // GoFlowClient is the client API for GoFlow service.
@@ -28,7 +39,18 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GoFlowClient interface {
 	PushTask(ctx context.Context, in *PushTaskRequest, opts ...grpc.CallOption) (*PushTaskReply, error)
+	PushTasks(ctx context.Context, in *PushTasksRequest, opts ...grpc.CallOption) (*PushTasksReply, error)
 	GetResult(ctx context.Context, in *GetResultRequest, opts ...grpc.CallOption) (*GetResultReply, error)
+	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusReply, error)
+	WatchResult(ctx context.Context, in *WatchResultRequest, opts ...grpc.CallOption) (GoFlow_WatchResultClient, error)
+	CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...grpc.CallOption) (*CancelTaskReply, error)
+	AddSchedule(ctx context.Context, in *AddScheduleRequest, opts ...grpc.CallOption) (*AddScheduleReply, error)
+	RemoveSchedule(ctx context.Context, in *RemoveScheduleRequest, opts ...grpc.CallOption) (*RemoveScheduleReply, error)
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesReply, error)
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersReply, error)
+	ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterReply, error)
+	PurgeDeadLetters(ctx context.Context, in *PurgeDeadLettersRequest, opts ...grpc.CallOption) (*PurgeDeadLettersReply, error)
+	ListResults(ctx context.Context, in *ListResultsRequest, opts ...grpc.CallOption) (*ListResultsReply, error)
 }
 
 type goFlowClient struct {
@@ -48,6 +70,15 @@ func (c *goFlowClient) PushTask(ctx context.Context, in *PushTaskRequest, opts .
 	return out, nil
 }
 
+func (c *goFlowClient) PushTasks(ctx context.Context, in *PushTasksRequest, opts ...grpc.CallOption) (*PushTasksReply, error) {
+	out := new(PushTasksReply)
+	err := c.cc.Invoke(ctx, GoFlow_PushTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goFlowClient) GetResult(ctx context.Context, in *GetResultRequest, opts ...grpc.CallOption) (*GetResultReply, error) {
 	out := new(GetResultReply)
 	err := c.cc.Invoke(ctx, GoFlow_GetResult_FullMethodName, in, out, opts...)
@@ -57,12 +88,136 @@ func (c *goFlowClient) GetResult(ctx context.Context, in *GetResultRequest, opts
 	return out, nil
 }
 
+func (c *goFlowClient) GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusReply, error) {
+	out := new(GetStatusReply)
+	err := c.cc.Invoke(ctx, GoFlow_GetStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFlowClient) WatchResult(ctx context.Context, in *WatchResultRequest, opts ...grpc.CallOption) (GoFlow_WatchResultClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoFlow_ServiceDesc.Streams[0], GoFlow_WatchResult_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goFlowWatchResultClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoFlow_WatchResultClient interface {
+	Recv() (*WatchResultReply, error)
+	grpc.ClientStream
+}
+
+type goFlowWatchResultClient struct {
+	grpc.ClientStream
+}
+
+func (x *goFlowWatchResultClient) Recv() (*WatchResultReply, error) {
+	m := new(WatchResultReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goFlowClient) CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...grpc.CallOption) (*CancelTaskReply, error) {
+	out := new(CancelTaskReply)
+	err := c.cc.Invoke(ctx, GoFlow_CancelTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFlowClient) AddSchedule(ctx context.Context, in *AddScheduleRequest, opts ...grpc.CallOption) (*AddScheduleReply, error) {
+	out := new(AddScheduleReply)
+	err := c.cc.Invoke(ctx, GoFlow_AddSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFlowClient) RemoveSchedule(ctx context.Context, in *RemoveScheduleRequest, opts ...grpc.CallOption) (*RemoveScheduleReply, error) {
+	out := new(RemoveScheduleReply)
+	err := c.cc.Invoke(ctx, GoFlow_RemoveSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFlowClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesReply, error) {
+	out := new(ListSchedulesReply)
+	err := c.cc.Invoke(ctx, GoFlow_ListSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFlowClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersReply, error) {
+	out := new(ListDeadLettersReply)
+	err := c.cc.Invoke(ctx, GoFlow_ListDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFlowClient) ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterReply, error) {
+	out := new(ReplayDeadLetterReply)
+	err := c.cc.Invoke(ctx, GoFlow_ReplayDeadLetter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFlowClient) PurgeDeadLetters(ctx context.Context, in *PurgeDeadLettersRequest, opts ...grpc.CallOption) (*PurgeDeadLettersReply, error) {
+	out := new(PurgeDeadLettersReply)
+	err := c.cc.Invoke(ctx, GoFlow_PurgeDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goFlowClient) ListResults(ctx context.Context, in *ListResultsRequest, opts ...grpc.CallOption) (*ListResultsReply, error) {
+	out := new(ListResultsReply)
+	err := c.cc.Invoke(ctx, GoFlow_ListResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoFlowServer is the server API for GoFlow service.
 // All implementations must embed UnimplementedGoFlowServer
 // for forward compatibility
 type GoFlowServer interface {
 	PushTask(context.Context, *PushTaskRequest) (*PushTaskReply, error)
+	PushTasks(context.Context, *PushTasksRequest) (*PushTasksReply, error)
 	GetResult(context.Context, *GetResultRequest) (*GetResultReply, error)
+	GetStatus(context.Context, *GetStatusRequest) (*GetStatusReply, error)
+	WatchResult(*WatchResultRequest, GoFlow_WatchResultServer) error
+	CancelTask(context.Context, *CancelTaskRequest) (*CancelTaskReply, error)
+	AddSchedule(context.Context, *AddScheduleRequest) (*AddScheduleReply, error)
+	RemoveSchedule(context.Context, *RemoveScheduleRequest) (*RemoveScheduleReply, error)
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesReply, error)
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersReply, error)
+	ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*ReplayDeadLetterReply, error)
+	PurgeDeadLetters(context.Context, *PurgeDeadLettersRequest) (*PurgeDeadLettersReply, error)
+	ListResults(context.Context, *ListResultsRequest) (*ListResultsReply, error)
 	mustEmbedUnimplementedGoFlowServer()
 }
 
@@ -73,9 +228,42 @@ type UnimplementedGoFlowServer struct {
 func (UnimplementedGoFlowServer) PushTask(context.Context, *PushTaskRequest) (*PushTaskReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PushTask not implemented")
 }
+func (UnimplementedGoFlowServer) PushTasks(context.Context, *PushTasksRequest) (*PushTasksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushTasks not implemented")
+}
 func (UnimplementedGoFlowServer) GetResult(context.Context, *GetResultRequest) (*GetResultReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResult not implemented")
 }
+func (UnimplementedGoFlowServer) GetStatus(context.Context, *GetStatusRequest) (*GetStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedGoFlowServer) WatchResult(*WatchResultRequest, GoFlow_WatchResultServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResult not implemented")
+}
+func (UnimplementedGoFlowServer) CancelTask(context.Context, *CancelTaskRequest) (*CancelTaskReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTask not implemented")
+}
+func (UnimplementedGoFlowServer) AddSchedule(context.Context, *AddScheduleRequest) (*AddScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSchedule not implemented")
+}
+func (UnimplementedGoFlowServer) RemoveSchedule(context.Context, *RemoveScheduleRequest) (*RemoveScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSchedule not implemented")
+}
+func (UnimplementedGoFlowServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedGoFlowServer) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedGoFlowServer) ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*ReplayDeadLetterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetter not implemented")
+}
+func (UnimplementedGoFlowServer) PurgeDeadLetters(context.Context, *PurgeDeadLettersRequest) (*PurgeDeadLettersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeadLetters not implemented")
+}
+func (UnimplementedGoFlowServer) ListResults(context.Context, *ListResultsRequest) (*ListResultsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResults not implemented")
+}
 func (UnimplementedGoFlowServer) mustEmbedUnimplementedGoFlowServer() {}
 
 // UnsafeGoFlowServer may be embedded to opt out of forward compatibility for this service.
@@ -107,6 +295,24 @@ func _GoFlow_PushTask_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoFlow_PushTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFlowServer).PushTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFlow_PushTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFlowServer).PushTasks(ctx, req.(*PushTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoFlow_GetResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetResultRequest)
 	if err := dec(in); err != nil {
@@ -125,6 +331,189 @@ func _GoFlow_GetResult_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoFlow_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFlowServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFlow_GetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFlowServer).GetStatus(ctx, req.(*GetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFlow_WatchResult_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchResultRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoFlowServer).WatchResult(m, &goFlowWatchResultServer{stream})
+}
+
+type GoFlow_WatchResultServer interface {
+	Send(*WatchResultReply) error
+	grpc.ServerStream
+}
+
+type goFlowWatchResultServer struct {
+	grpc.ServerStream
+}
+
+func (x *goFlowWatchResultServer) Send(m *WatchResultReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoFlow_CancelTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFlowServer).CancelTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFlow_CancelTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFlowServer).CancelTask(ctx, req.(*CancelTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFlow_AddSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFlowServer).AddSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFlow_AddSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFlowServer).AddSchedule(ctx, req.(*AddScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFlow_RemoveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFlowServer).RemoveSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFlow_RemoveSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFlowServer).RemoveSchedule(ctx, req.(*RemoveScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFlow_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFlowServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFlow_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFlowServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFlow_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFlowServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFlow_ListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFlowServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFlow_ReplayDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFlowServer).ReplayDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFlow_ReplayDeadLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFlowServer).ReplayDeadLetter(ctx, req.(*ReplayDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFlow_PurgeDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFlowServer).PurgeDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFlow_PurgeDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFlowServer).PurgeDeadLetters(ctx, req.(*PurgeDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoFlow_ListResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoFlowServer).ListResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoFlow_ListResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoFlowServer).ListResults(ctx, req.(*ListResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GoFlow_ServiceDesc is the grpc.ServiceDesc for GoFlow service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -136,11 +525,57 @@ var GoFlow_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PushTask",
 			Handler:    _GoFlow_PushTask_Handler,
 		},
+		{
+			MethodName: "PushTasks",
+			Handler:    _GoFlow_PushTasks_Handler,
+		},
 		{
 			MethodName: "GetResult",
 			Handler:    _GoFlow_GetResult_Handler,
 		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _GoFlow_GetStatus_Handler,
+		},
+		{
+			MethodName: "CancelTask",
+			Handler:    _GoFlow_CancelTask_Handler,
+		},
+		{
+			MethodName: "AddSchedule",
+			Handler:    _GoFlow_AddSchedule_Handler,
+		},
+		{
+			MethodName: "RemoveSchedule",
+			Handler:    _GoFlow_RemoveSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _GoFlow_ListSchedules_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _GoFlow_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetter",
+			Handler:    _GoFlow_ReplayDeadLetter_Handler,
+		},
+		{
+			MethodName: "PurgeDeadLetters",
+			Handler:    _GoFlow_PurgeDeadLetters_Handler,
+		},
+		{
+			MethodName: "ListResults",
+			Handler:    _GoFlow_ListResults_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchResult",
+			Handler:       _GoFlow_WatchResult_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc/proto/goflow.proto",
 }
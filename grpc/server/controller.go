@@ -3,19 +3,43 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/jamesTait-jt/goflow"
+	"github.com/jamesTait-jt/goflow/deadletter"
 	pb "github.com/jamesTait-jt/goflow/grpc/proto"
+	"github.com/jamesTait-jt/goflow/schedule"
 	"github.com/jamesTait-jt/goflow/task"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/jamesTait-jt/goflow/pkg/log"
 )
 
 type goFlowService interface {
-	PushTask(taskType string, payload any) (string, error)
+	PushTask(taskType string, payload any, opts ...goflow.PushOption) (string, error)
+	PushTasks(requests []goflow.PushRequest) ([]string, error)
 	GetResult(taskID string) (task.Result, bool, error)
+	GetStatus(taskID string) (task.Status, bool, error)
+	SubscribeContext(ctx context.Context, taskIDs ...string) <-chan task.Result
+	CancelTask(taskID string) error
+	AddSchedule(taskType string, payload any, spec string) (string, error)
+	RemoveSchedule(scheduleID string) error
+	ListSchedules() ([]schedule.Schedule, error)
+	ListDeadLetters() ([]deadletter.Entry, error)
+	ReplayDeadLetter(taskID string) (string, error)
+	PurgeDeadLetters() (int, error)
+	ListResults(filter goflow.ResultFilter, cursor string, limit int) ([]task.Result, string, error)
 }
 
+var (
+	defaultListResultsLimit = 100
+	maxListResultsLimit     = 1000
+)
+
 type GoFlowServiceController struct {
 	svc    goFlowService
 	logger log.Logger
@@ -29,7 +53,11 @@ func NewGoFlowServiceController(svc goFlowService, logger log.Logger) *GoFlowSer
 func (c *GoFlowServiceController) PushTask(_ context.Context, in *pb.PushTaskRequest) (*pb.PushTaskReply, error) {
 	c.logger.Info(fmt.Sprintf("Received push task: [%s] [%s]", in.GetTaskType(), in.GetPayload()))
 
-	id, err := c.svc.PushTask(in.GetTaskType(), in.GetPayload())
+	id, err := c.svc.PushTask(in.GetTaskType(), in.GetPayload(), pushOptions(in)...)
+	if errors.Is(err, goflow.ErrInvalidPriority) {
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -37,6 +65,58 @@ func (c *GoFlowServiceController) PushTask(_ context.Context, in *pb.PushTaskReq
 	return &pb.PushTaskReply{Id: id}, nil
 }
 
+func (c *GoFlowServiceController) PushTasks(_ context.Context, in *pb.PushTasksRequest) (*pb.PushTasksReply, error) {
+	c.logger.Info(fmt.Sprintf("Received push tasks: [%d tasks]", len(in.GetTasks())))
+
+	requests := make([]goflow.PushRequest, 0, len(in.GetTasks()))
+	for _, t := range in.GetTasks() {
+		requests = append(requests, goflow.PushRequest{
+			TaskType: t.GetTaskType(),
+			Payload:  t.GetPayload(),
+			Opts:     pushOptions(t),
+		})
+	}
+
+	ids, err := c.svc.PushTasks(requests)
+	if errors.Is(err, goflow.ErrInvalidPriority) {
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PushTasksReply{Ids: ids}, nil
+}
+
+// pushOptions translates the optional fields of a push request into GoFlow push
+// options. Unset fields produce no option, so GoFlow's defaults apply.
+func pushOptions(in *pb.PushTaskRequest) []goflow.PushOption {
+	var opts []goflow.PushOption
+
+	if in.GetTimeout() != nil {
+		opts = append(opts, goflow.WithTaskTimeout(in.GetTimeout().AsDuration()))
+	}
+
+	if in.GetRunAt() != nil {
+		opts = append(opts, goflow.WithRunAt(in.GetRunAt().AsTime()))
+	}
+
+	if in.GetPriority() != 0 {
+		opts = append(opts, goflow.WithPriority(int(in.GetPriority())))
+	}
+
+	if in.GetIdempotencyKey() != "" {
+		opts = append(opts, goflow.WithIdempotencyKey(in.GetIdempotencyKey()))
+	}
+
+	if len(in.GetMetadata()) > 0 {
+		opts = append(opts, goflow.WithMetadata(in.GetMetadata()))
+	}
+
+	return opts
+}
+
 func (c *GoFlowServiceController) GetResult(_ context.Context, in *pb.GetResultRequest) (*pb.GetResultReply, error) {
 	c.logger.Info(fmt.Sprintf("Received get result: [%s]", in.GetTaskID()))
 
@@ -46,7 +126,7 @@ func (c *GoFlowServiceController) GetResult(_ context.Context, in *pb.GetResultR
 	}
 
 	if !ok {
-		return nil, fmt.Errorf("task not complete or didnt exist")
+		return nil, c.resultNotFound(in.GetTaskID())
 	}
 
 	if result.Payload == nil {
@@ -55,18 +135,9 @@ func (c *GoFlowServiceController) GetResult(_ context.Context, in *pb.GetResultR
 		}, nil
 	}
 
-	var parsedPayload string
-
-	switch p := result.Payload.(type) {
-	case string:
-		parsedPayload = p
-	default:
-		marshalledPayload, err := json.Marshal(p)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal result payload: %v", result)
-		}
-
-		parsedPayload = string(marshalledPayload)
+	parsedPayload, err := payloadString(result.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result payload: %v", result)
 	}
 
 	return &pb.GetResultReply{
@@ -74,3 +145,327 @@ func (c *GoFlowServiceController) GetResult(_ context.Context, in *pb.GetResultR
 		ErrMsg: result.ErrMsg,
 	}, nil
 }
+
+// resultNotFound explains why there is no result for the task: either it has not
+// finished yet, or it does not exist.
+func (c *GoFlowServiceController) resultNotFound(taskID string) error {
+	status, ok, err := c.svc.GetStatus(taskID)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return grpcstatus.Errorf(codes.NotFound, "task %s not found", taskID)
+	}
+
+	return grpcstatus.Errorf(codes.FailedPrecondition, "task %s is %s", taskID, status.State)
+}
+
+func (c *GoFlowServiceController) GetStatus(_ context.Context, in *pb.GetStatusRequest) (*pb.GetStatusReply, error) {
+	c.logger.Info(fmt.Sprintf("Received get status: [%s]", in.GetTaskID()))
+
+	status, ok, err := c.svc.GetStatus(in.GetTaskID())
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, grpcstatus.Errorf(codes.NotFound, "task %s not found", in.GetTaskID())
+	}
+
+	return &pb.GetStatusReply{
+		State:      string(status.State),
+		Attempt:    int32(status.Attempt), // nolint:gosec // attempts are small
+		EnqueuedAt: timestamp(status.EnqueuedAt),
+		StartedAt:  timestamp(status.StartedAt),
+		FinishedAt: timestamp(status.FinishedAt),
+		Metadata:   status.Metadata,
+	}, nil
+}
+
+// WatchResult streams the result of each requested task as it completes, ending the
+// stream once every result has been sent.
+func (c *GoFlowServiceController) WatchResult(in *pb.WatchResultRequest, stream pb.GoFlow_WatchResultServer) error {
+	c.logger.Info(fmt.Sprintf("Received watch result: %v", in.GetTaskIDs()))
+
+	if len(in.GetTaskIDs()) == 0 {
+		return grpcstatus.Error(codes.InvalidArgument, "at least one task ID is required")
+	}
+
+	remaining := map[string]struct{}{}
+	for _, id := range in.GetTaskIDs() {
+		remaining[id] = struct{}{}
+	}
+
+	for result := range c.svc.SubscribeContext(stream.Context(), in.GetTaskIDs()...) {
+		delete(remaining, result.TaskID)
+
+		reply := &pb.WatchResultReply{TaskID: result.TaskID, ErrMsg: result.ErrMsg}
+
+		if result.Payload != nil {
+			parsedPayload, err := payloadString(result.Payload)
+			if err != nil {
+				return fmt.Errorf("failed to marshal result payload: %v", result)
+			}
+
+			reply.Result = parsedPayload
+		}
+
+		if err := stream.Send(reply); err != nil {
+			return err
+		}
+	}
+
+	if len(remaining) == 0 {
+		return nil
+	}
+
+	if err := stream.Context().Err(); err != nil {
+		return grpcstatus.FromContextError(err).Err()
+	}
+
+	return grpcstatus.Error(codes.Unavailable, "server shut down before all results were available")
+}
+
+func (c *GoFlowServiceController) CancelTask(_ context.Context, in *pb.CancelTaskRequest) (*pb.CancelTaskReply, error) {
+	c.logger.Info(fmt.Sprintf("Received cancel task: [%s]", in.GetTaskID()))
+
+	err := c.svc.CancelTask(in.GetTaskID())
+
+	switch {
+	case errors.Is(err, goflow.ErrTaskNotFound):
+		return nil, grpcstatus.Errorf(codes.NotFound, "task %s not found", in.GetTaskID())
+
+	case errors.Is(err, goflow.ErrTaskFinished):
+		return nil, grpcstatus.Errorf(codes.FailedPrecondition, "task %s has already finished", in.GetTaskID())
+
+	case errors.Is(err, goflow.ErrCancelUnsupported):
+		return nil, grpcstatus.Error(codes.Unimplemented, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	return &pb.CancelTaskReply{}, nil
+}
+
+// timestamp converts t to a protobuf timestamp, leaving it unset if t is zero.
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+func (c *GoFlowServiceController) AddSchedule(_ context.Context, in *pb.AddScheduleRequest) (*pb.AddScheduleReply, error) {
+	c.logger.Info(fmt.Sprintf("Received add schedule: [%s] [%s] [%s]", in.GetTaskType(), in.GetPayload(), in.GetSpec()))
+
+	id, err := c.svc.AddSchedule(in.GetTaskType(), in.GetPayload(), in.GetSpec())
+	if errors.Is(err, schedule.ErrInvalidSpec) || errors.Is(err, schedule.ErrNeverRuns) {
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddScheduleReply{Id: id}, nil
+}
+
+func (c *GoFlowServiceController) RemoveSchedule(
+	_ context.Context,
+	in *pb.RemoveScheduleRequest,
+) (*pb.RemoveScheduleReply, error) {
+	c.logger.Info(fmt.Sprintf("Received remove schedule: [%s]", in.GetId()))
+
+	err := c.svc.RemoveSchedule(in.GetId())
+	if errors.Is(err, schedule.ErrNotFound) {
+		return nil, grpcstatus.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveScheduleReply{}, nil
+}
+
+func (c *GoFlowServiceController) ListSchedules(
+	_ context.Context,
+	_ *pb.ListSchedulesRequest,
+) (*pb.ListSchedulesReply, error) {
+	c.logger.Info("Received list schedules")
+
+	schedules, err := c.svc.ListSchedules()
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListSchedulesReply{Schedules: make([]*pb.Schedule, 0, len(schedules))}
+
+	for _, s := range schedules {
+		payload, err := payloadString(s.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal schedule payload: %v", s)
+		}
+
+		reply.Schedules = append(reply.Schedules, &pb.Schedule{
+			Id:       s.ID,
+			TaskType: s.TaskType,
+			Payload:  payload,
+			Spec:     s.Spec,
+			NextRun:  timestamppb.New(s.NextRun),
+		})
+	}
+
+	return reply, nil
+}
+
+func (c *GoFlowServiceController) ListDeadLetters(
+	_ context.Context,
+	_ *pb.ListDeadLettersRequest,
+) (*pb.ListDeadLettersReply, error) {
+	c.logger.Info("Received list dead letters")
+
+	entries, err := c.svc.ListDeadLetters()
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListDeadLettersReply{DeadLetters: make([]*pb.DeadLetter, 0, len(entries))}
+
+	for _, e := range entries {
+		payload, err := payloadString(e.Task.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal dead letter payload: %v", e)
+		}
+
+		reply.DeadLetters = append(reply.DeadLetters, &pb.DeadLetter{
+			TaskID:   e.Task.ID,
+			TaskType: e.Task.Type,
+			Payload:  payload,
+			Reason:   e.Reason,
+			Attempts: int32(e.Attempts), // nolint:gosec // attempts are small
+			FailedAt: timestamppb.New(e.FailedAt),
+			Metadata: e.Task.Metadata,
+		})
+	}
+
+	return reply, nil
+}
+
+func (c *GoFlowServiceController) ReplayDeadLetter(
+	_ context.Context,
+	in *pb.ReplayDeadLetterRequest,
+) (*pb.ReplayDeadLetterReply, error) {
+	c.logger.Info(fmt.Sprintf("Received replay dead letter: [%s]", in.GetTaskID()))
+
+	id, err := c.svc.ReplayDeadLetter(in.GetTaskID())
+	if errors.Is(err, deadletter.ErrNotFound) {
+		return nil, grpcstatus.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReplayDeadLetterReply{Id: id}, nil
+}
+
+func (c *GoFlowServiceController) PurgeDeadLetters(
+	_ context.Context,
+	_ *pb.PurgeDeadLettersRequest,
+) (*pb.PurgeDeadLettersReply, error) {
+	c.logger.Info("Received purge dead letters")
+
+	purged, err := c.svc.PurgeDeadLetters()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PurgeDeadLettersReply{Purged: int32(purged)}, nil // nolint:gosec // counts fit in an int32
+}
+
+// ListResults returns a page of the finished tasks' results that match the filter.
+// Only the last page holds fewer than limit results.
+func (c *GoFlowServiceController) ListResults(_ context.Context, in *pb.ListResultsRequest) (*pb.ListResultsReply, error) {
+	c.logger.Info(fmt.Sprintf("Received list results: [%s] [%s] [%s]", in.GetTaskType(), in.GetState(), in.GetCursor()))
+
+	state := task.State(in.GetState())
+	if state != "" && !state.Terminal() {
+		return nil, grpcstatus.Errorf(codes.InvalidArgument, "state must be succeeded, failed or cancelled, not %q", state)
+	}
+
+	filter := goflow.ResultFilter{TaskType: in.GetTaskType(), State: state, Metadata: in.GetMetadata()}
+
+	if in.GetFinishedAfter() != nil {
+		filter.FinishedAfter = in.GetFinishedAfter().AsTime()
+	}
+
+	if in.GetFinishedBefore() != nil {
+		filter.FinishedBefore = in.GetFinishedBefore().AsTime()
+	}
+
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultListResultsLimit
+	}
+
+	limit = min(limit, maxListResultsLimit)
+
+	results, next, err := c.svc.ListResults(filter, in.GetCursor(), limit)
+	if errors.Is(err, goflow.ErrListUnsupported) {
+		return nil, grpcstatus.Error(codes.Unimplemented, err.Error())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListResultsReply{Results: make([]*pb.TaskResult, 0, len(results)), NextCursor: next}
+
+	for _, result := range results {
+		status := result.Status()
+
+		taskResult := &pb.TaskResult{
+			TaskID:     result.TaskID,
+			TaskType:   result.TaskType,
+			State:      string(status.State),
+			ErrMsg:     result.ErrMsg,
+			Attempt:    int32(status.Attempt), // nolint:gosec // attempts are small
+			EnqueuedAt: timestamp(status.EnqueuedAt),
+			StartedAt:  timestamp(status.StartedAt),
+			FinishedAt: timestamp(status.FinishedAt),
+			Metadata:   result.Metadata,
+		}
+
+		if result.Payload != nil {
+			payload, err := payloadString(result.Payload)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal result payload: %v", result)
+			}
+
+			taskResult.Result = payload
+		}
+
+		reply.Results = append(reply.Results, taskResult)
+	}
+
+	return reply, nil
+}
+
+// payloadString converts a task or result payload to the string sent over gRPC.
+// Payloads pushed over gRPC are already strings; anything else is sent as JSON.
+func payloadString(payload any) (string, error) {
+	if p, ok := payload.(string); ok {
+		return p, nil
+	}
+
+	marshalledPayload, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return string(marshalledPayload), nil
+}
@@ -6,12 +6,21 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/jamesTait-jt/goflow"
+	"github.com/jamesTait-jt/goflow/deadletter"
 	pb "github.com/jamesTait-jt/goflow/grpc/proto"
 	"github.com/jamesTait-jt/goflow/pkg/log"
+	"github.com/jamesTait-jt/goflow/schedule"
 	"github.com/jamesTait-jt/goflow/task"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_GoFlowServiceController_PushTask(t *testing.T) {
@@ -32,7 +41,7 @@ func Test_GoFlowServiceController_PushTask(t *testing.T) {
 		logger.On("Info", shouldLog).Once()
 
 		taskID := "task-id"
-		svc.On("PushTask", req.TaskType, req.Payload).Once().Return(taskID, nil)
+		svc.On("PushTask", req.TaskType, req.Payload, []goflow.PushOption(nil)).Once().Return(taskID, nil)
 
 		expectedReply := &pb.PushTaskReply{Id: taskID}
 
@@ -47,6 +56,187 @@ func Test_GoFlowServiceController_PushTask(t *testing.T) {
 		logger.AssertExpectations(t)
 	})
 
+	t.Run("Passes the task timeout to GoFlow if one is set", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx := context.Background()
+		req := &pb.PushTaskRequest{
+			TaskType: "task-type",
+			Payload:  "12345",
+			Timeout:  durationpb.New(time.Minute),
+		}
+
+		logger.On("Info", "Received push task: [task-type] [12345]").Once()
+
+		taskID := "task-id"
+		svc.On("PushTask", req.TaskType, req.Payload, []goflow.PushOption{goflow.WithTaskTimeout(time.Minute)}).
+			Once().
+			Return(taskID, nil)
+
+		// Act
+		resp, err := controller.PushTask(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.PushTaskReply{Id: taskID}, resp)
+
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Passes the idempotency key to GoFlow if one is set", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx := context.Background()
+		req := &pb.PushTaskRequest{
+			TaskType:       "task-type",
+			Payload:        "12345",
+			IdempotencyKey: "key",
+		}
+
+		logger.On("Info", "Received push task: [task-type] [12345]").Once()
+
+		svc.On("PushTask", req.TaskType, req.Payload, []goflow.PushOption{goflow.WithIdempotencyKey("key")}).
+			Once().
+			Return("task-id", nil)
+
+		// Act
+		resp, err := controller.PushTask(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.PushTaskReply{Id: "task-id"}, resp)
+
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Passes the metadata to GoFlow if any is set", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx := context.Background()
+		metadata := map[string]string{"trace_id": "abc"}
+		req := &pb.PushTaskRequest{
+			TaskType: "task-type",
+			Payload:  "12345",
+			Metadata: metadata,
+		}
+
+		logger.On("Info", "Received push task: [task-type] [12345]").Once()
+
+		svc.On("PushTask", req.TaskType, req.Payload, []goflow.PushOption{goflow.WithMetadata(metadata)}).
+			Once().
+			Return("task-id", nil)
+
+		// Act
+		_, err := controller.PushTask(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Passes the run time to GoFlow if one is set", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx := context.Background()
+		runAt := time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC)
+		req := &pb.PushTaskRequest{
+			TaskType: "task-type",
+			Payload:  "12345",
+			RunAt:    timestamppb.New(runAt),
+		}
+
+		logger.On("Info", "Received push task: [task-type] [12345]").Once()
+
+		taskID := "task-id"
+		svc.On("PushTask", req.TaskType, req.Payload, []goflow.PushOption{goflow.WithRunAt(runAt)}).
+			Once().
+			Return(taskID, nil)
+
+		// Act
+		resp, err := controller.PushTask(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.PushTaskReply{Id: taskID}, resp)
+
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Passes the priority to GoFlow if one is set", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx := context.Background()
+		req := &pb.PushTaskRequest{
+			TaskType: "task-type",
+			Payload:  "12345",
+			Priority: 4,
+		}
+
+		logger.On("Info", "Received push task: [task-type] [12345]").Once()
+
+		svc.On("PushTask", req.TaskType, req.Payload, []goflow.PushOption{goflow.WithPriority(4)}).
+			Once().
+			Return("task-id", nil)
+
+		// Act
+		resp, err := controller.PushTask(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.PushTaskReply{Id: "task-id"}, resp)
+
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Returns an invalid argument error if the priority is out of range", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx := context.Background()
+		req := &pb.PushTaskRequest{
+			TaskType: "task-type",
+			Payload:  "12345",
+			Priority: 100,
+		}
+
+		logger.On("Info", "Received push task: [task-type] [12345]").Once()
+
+		svc.On("PushTask", req.TaskType, req.Payload, []goflow.PushOption{goflow.WithPriority(100)}).
+			Once().
+			Return("", goflow.ErrInvalidPriority)
+
+		// Act
+		resp, err := controller.PushTask(ctx, req)
+
+		// Assert
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
 	t.Run("Returns an error if the push failed", func(t *testing.T) {
 		// Arrange
 		svc := new(mockGoFlowService)
@@ -64,7 +254,7 @@ func Test_GoFlowServiceController_PushTask(t *testing.T) {
 		logger.On("Info", shouldLog).Once()
 
 		pushTaskErr := errors.New("couldn't push task")
-		svc.On("PushTask", req.TaskType, req.Payload).Once().Return("", pushTaskErr)
+		svc.On("PushTask", req.TaskType, req.Payload, []goflow.PushOption(nil)).Once().Return("", pushTaskErr)
 
 		// Act
 		resp, err := controller.PushTask(ctx, req)
@@ -78,6 +268,80 @@ func Test_GoFlowServiceController_PushTask(t *testing.T) {
 	})
 }
 
+func Test_GoFlowServiceController_PushTasks(t *testing.T) {
+	t.Run("Logs the request, pushes every task in one batch and returns the IDs", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		req := &pb.PushTasksRequest{Tasks: []*pb.PushTaskRequest{
+			{TaskType: "resize", Payload: "a"},
+			{TaskType: "index", Payload: "b", Priority: 5},
+		}}
+
+		logger.On("Info", "Received push tasks: [2 tasks]").Once()
+
+		svc.On("PushTasks", []goflow.PushRequest{
+			{TaskType: "resize", Payload: "a"},
+			{TaskType: "index", Payload: "b", Opts: []goflow.PushOption{goflow.WithPriority(5)}},
+		}).Once().Return([]string{"id-1", "id-2"}, nil)
+
+		// Act
+		resp, err := controller.PushTasks(context.Background(), req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"id-1", "id-2"}, resp.GetIds())
+
+		svc.AssertExpectations(t)
+		logger.AssertExpectations(t)
+	})
+
+	t.Run("Returns InvalidArgument for an invalid priority", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		logger.On("Info", mock.Anything).Once()
+		svc.On("PushTasks", mock.Anything).Once().Return([]string(nil), goflow.ErrInvalidPriority)
+
+		// Act
+		resp, err := controller.PushTasks(context.Background(), &pb.PushTasksRequest{
+			Tasks: []*pb.PushTaskRequest{{TaskType: "resize", Priority: 10}},
+		})
+
+		// Assert
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, resp)
+	})
+
+	t.Run("Returns an error if pushing the tasks fails", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		pushErr := errors.New("broker down")
+
+		logger.On("Info", mock.Anything).Once()
+		svc.On("PushTasks", mock.Anything).Once().Return([]string(nil), pushErr)
+
+		// Act
+		resp, err := controller.PushTasks(context.Background(), &pb.PushTasksRequest{
+			Tasks: []*pb.PushTaskRequest{{TaskType: "resize"}},
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, pushErr)
+		assert.Nil(t, resp)
+	})
+}
+
 func Test_GoFlowServiceController_GetResult(t *testing.T) {
 	t.Run("Logs the request, gets the result from GoFlow and returns the result", func(t *testing.T) {
 		type successTest struct {
@@ -156,7 +420,7 @@ func Test_GoFlowServiceController_GetResult(t *testing.T) {
 		logger.AssertExpectations(t)
 	})
 
-	t.Run("Returns an error if the task is not complete or does not exist", func(t *testing.T) {
+	t.Run("Returns NotFound if the task does not exist", func(t *testing.T) {
 		// Arrange
 		svc := new(mockGoFlowService)
 		logger := new(log.TestifyMock)
@@ -172,12 +436,43 @@ func Test_GoFlowServiceController_GetResult(t *testing.T) {
 		logger.On("Info", shouldLog).Once()
 
 		svc.On("GetResult", req.TaskID).Once().Return(task.Result{}, false, nil)
+		svc.On("GetStatus", req.TaskID).Once().Return(task.Status{}, false, nil)
+
+		// Act
+		resp, err := controller.GetResult(ctx, req)
+
+		// Assert
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, resp)
+
+		svc.AssertExpectations(t)
+		logger.AssertExpectations(t)
+	})
+
+	t.Run("Returns FailedPrecondition if the task has not finished", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx := context.Background()
+		req := &pb.GetResultRequest{
+			TaskID: "task-id",
+		}
+
+		shouldLog := "Received get result: [task-id]"
+		logger.On("Info", shouldLog).Once()
+
+		svc.On("GetResult", req.TaskID).Once().Return(task.Result{}, false, nil)
+		svc.On("GetStatus", req.TaskID).Once().Return(task.Status{TaskID: "task-id", State: task.StateRunning}, true, nil)
 
 		// Act
 		resp, err := controller.GetResult(ctx, req)
 
 		// Assert
-		assert.EqualError(t, err, "task not complete or didnt exist")
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "task task-id is running")
 		assert.Nil(t, resp)
 
 		svc.AssertExpectations(t)
@@ -217,16 +512,650 @@ func Test_GoFlowServiceController_GetResult(t *testing.T) {
 	})
 }
 
-type mockGoFlowService struct {
-	mock.Mock
-}
+func Test_GoFlowServiceController_GetStatus(t *testing.T) {
+	t.Run("Logs the request and returns the status of the task", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
 
-func (m *mockGoFlowService) PushTask(taskType string, payload any) (string, error) {
-	args := m.Called(taskType, payload)
-	return args.String(0), args.Error(1)
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx := context.Background()
+		req := &pb.GetStatusRequest{
+			TaskID: "task-id",
+		}
+
+		logger.On("Info", "Received get status: [task-id]").Once()
+
+		enqueuedAt := time.Unix(100, 0)
+		startedAt := time.Unix(200, 0)
+		svc.On("GetStatus", req.TaskID).Once().Return(task.Status{
+			TaskID:     "task-id",
+			State:      task.StateRunning,
+			Attempt:    2,
+			EnqueuedAt: enqueuedAt,
+			StartedAt:  startedAt,
+			Metadata:   map[string]string{"trace_id": "abc"},
+		}, true, nil)
+
+		// Act
+		resp, err := controller.GetStatus(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "running", resp.GetState())
+		assert.Equal(t, int32(2), resp.GetAttempt())
+		assert.True(t, resp.GetEnqueuedAt().AsTime().Equal(enqueuedAt))
+		assert.True(t, resp.GetStartedAt().AsTime().Equal(startedAt))
+		assert.Nil(t, resp.GetFinishedAt())
+		assert.Equal(t, map[string]string{"trace_id": "abc"}, resp.GetMetadata())
+
+		svc.AssertExpectations(t)
+		logger.AssertExpectations(t)
+	})
+
+	t.Run("Returns NotFound if the task does not exist", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx := context.Background()
+		req := &pb.GetStatusRequest{
+			TaskID: "task-id",
+		}
+
+		logger.On("Info", "Received get status: [task-id]").Once()
+		svc.On("GetStatus", req.TaskID).Once().Return(task.Status{}, false, nil)
+
+		// Act
+		resp, err := controller.GetStatus(ctx, req)
+
+		// Assert
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, resp)
+
+		svc.AssertExpectations(t)
+	})
 }
 
-func (m *mockGoFlowService) GetResult(taskID string) (task.Result, bool, error) {
-	args := m.Called(taskID)
-	return args.Get(0).(task.Result), args.Bool(1), args.Error(2)
+func Test_GoFlowServiceController_WatchResult(t *testing.T) {
+	t.Run("Streams each result as it arrives and then ends the stream", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		stream := &mockWatchResultStream{ctx: context.Background()}
+		req := &pb.WatchResultRequest{TaskIDs: []string{"a", "b"}}
+
+		logger.On("Info", "Received watch result: [a b]").Once()
+
+		results := make(chan task.Result, 2)
+		results <- task.Result{TaskID: "b", ErrMsg: "failed"}
+		results <- task.Result{TaskID: "a", Payload: "done"}
+		close(results)
+
+		svc.On("SubscribeContext", stream.ctx, []string{"a", "b"}).Once().Return((<-chan task.Result)(results))
+
+		// Act
+		err := controller.WatchResult(req, stream)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []*pb.WatchResultReply{
+			{TaskID: "b", ErrMsg: "failed"},
+			{TaskID: "a", Result: "done"},
+		}, stream.sent)
+
+		svc.AssertExpectations(t)
+		logger.AssertExpectations(t)
+	})
+
+	t.Run("Returns InvalidArgument if no task IDs are given", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		logger.On("Info", "Received watch result: []").Once()
+
+		// Act
+		err := controller.WatchResult(&pb.WatchResultRequest{}, &mockWatchResultStream{ctx: context.Background()})
+
+		// Assert
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		svc.AssertNotCalled(t, "SubscribeContext")
+	})
+
+	t.Run("Returns Unavailable if the subscription ends before every result arrives", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		stream := &mockWatchResultStream{ctx: context.Background()}
+
+		logger.On("Info", mock.Anything).Once()
+
+		results := make(chan task.Result)
+		close(results)
+
+		svc.On("SubscribeContext", stream.ctx, []string{"a"}).Once().Return((<-chan task.Result)(results))
+
+		// Act
+		err := controller.WatchResult(&pb.WatchResultRequest{TaskIDs: []string{"a"}}, stream)
+
+		// Assert
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("Returns Canceled if the client goes away", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		stream := &mockWatchResultStream{ctx: ctx}
+
+		logger.On("Info", mock.Anything).Once()
+
+		results := make(chan task.Result)
+		close(results)
+
+		svc.On("SubscribeContext", ctx, []string{"a"}).Once().Return((<-chan task.Result)(results))
+
+		// Act
+		err := controller.WatchResult(&pb.WatchResultRequest{TaskIDs: []string{"a"}}, stream)
+
+		// Assert
+		assert.Equal(t, codes.Canceled, status.Code(err))
+	})
+}
+
+func Test_GoFlowServiceController_CancelTask(t *testing.T) {
+	t.Run("Logs the request and cancels the task", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx := context.Background()
+		req := &pb.CancelTaskRequest{
+			TaskID: "task-id",
+		}
+
+		logger.On("Info", "Received cancel task: [task-id]").Once()
+		svc.On("CancelTask", req.TaskID).Once().Return(nil)
+
+		// Act
+		resp, err := controller.CancelTask(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+
+		svc.AssertExpectations(t)
+		logger.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"Returns NotFound if the task does not exist", goflow.ErrTaskNotFound, codes.NotFound},
+		{"Returns FailedPrecondition if the task has finished", goflow.ErrTaskFinished, codes.FailedPrecondition},
+		{"Returns Unimplemented if the broker cannot cancel tasks", goflow.ErrCancelUnsupported, codes.Unimplemented},
+		{"Returns other errors unchanged", errors.New("redis down"), codes.Unknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			svc := new(mockGoFlowService)
+			logger := new(log.TestifyMock)
+
+			controller := NewGoFlowServiceController(svc, logger)
+
+			logger.On("Info", "Received cancel task: [task-id]").Once()
+			svc.On("CancelTask", "task-id").Once().Return(tt.err)
+
+			// Act
+			resp, err := controller.CancelTask(context.Background(), &pb.CancelTaskRequest{TaskID: "task-id"})
+
+			// Assert
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Nil(t, resp)
+		})
+	}
+}
+
+func Test_GoFlowServiceController_AddSchedule(t *testing.T) {
+	t.Run("Logs the request, adds the schedule to GoFlow and returns its ID", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx := context.Background()
+		req := &pb.AddScheduleRequest{
+			TaskType: "task-type",
+			Payload:  "12345",
+			Spec:     "@hourly",
+		}
+
+		logger.On("Info", "Received add schedule: [task-type] [12345] [@hourly]").Once()
+		svc.On("AddSchedule", req.TaskType, req.Payload, req.Spec).Once().Return("schedule-id", nil)
+
+		// Act
+		resp, err := controller.AddSchedule(ctx, req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.AddScheduleReply{Id: "schedule-id"}, resp)
+
+		svc.AssertExpectations(t)
+		logger.AssertExpectations(t)
+	})
+
+	t.Run("Returns an invalid argument error if the spec is invalid", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		ctx := context.Background()
+		req := &pb.AddScheduleRequest{TaskType: "task-type", Payload: "12345", Spec: "bad"}
+
+		logger.On("Info", "Received add schedule: [task-type] [12345] [bad]").Once()
+		svc.On("AddSchedule", req.TaskType, req.Payload, req.Spec).Once().Return("", schedule.ErrInvalidSpec)
+
+		// Act
+		resp, err := controller.AddSchedule(ctx, req)
+
+		// Assert
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func Test_GoFlowServiceController_RemoveSchedule(t *testing.T) {
+	t.Run("Removes the schedule from GoFlow", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		logger.On("Info", "Received remove schedule: [schedule-id]").Once()
+		svc.On("RemoveSchedule", "schedule-id").Once().Return(nil)
+
+		// Act
+		resp, err := controller.RemoveSchedule(context.Background(), &pb.RemoveScheduleRequest{Id: "schedule-id"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.RemoveScheduleReply{}, resp)
+
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Returns a not found error if the schedule does not exist", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		logger.On("Info", "Received remove schedule: [missing]").Once()
+		svc.On("RemoveSchedule", "missing").Once().Return(schedule.ErrNotFound)
+
+		// Act
+		resp, err := controller.RemoveSchedule(context.Background(), &pb.RemoveScheduleRequest{Id: "missing"})
+
+		// Assert
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func Test_GoFlowServiceController_ListSchedules(t *testing.T) {
+	t.Run("Returns every schedule registered with GoFlow", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		nextRun := time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC)
+
+		logger.On("Info", "Received list schedules").Once()
+		svc.On("ListSchedules").Once().Return([]schedule.Schedule{
+			{ID: "a", Spec: "0 2 * * *", TaskType: "report", Payload: "payload", NextRun: nextRun},
+			{ID: "b", Spec: "@every 1m", TaskType: "ping", Payload: 10, NextRun: nextRun},
+		}, nil)
+
+		// Act
+		resp, err := controller.ListSchedules(context.Background(), &pb.ListSchedulesRequest{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.ListSchedulesReply{Schedules: []*pb.Schedule{
+			{Id: "a", TaskType: "report", Payload: "payload", Spec: "0 2 * * *", NextRun: timestamppb.New(nextRun)},
+			{Id: "b", TaskType: "ping", Payload: "10", Spec: "@every 1m", NextRun: timestamppb.New(nextRun)},
+		}}, resp)
+
+		svc.AssertExpectations(t)
+	})
+}
+
+func Test_GoFlowServiceController_ListDeadLetters(t *testing.T) {
+	t.Run("Returns every dead letter", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		failedAt := time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC)
+
+		logger.On("Info", "Received list dead letters").Once()
+		svc.On("ListDeadLetters").Once().Return([]deadletter.Entry{
+			{
+				Task:     task.Task{ID: "a", Type: "resize", Payload: "payload"},
+				Reason:   "always fails",
+				Attempts: 3,
+				FailedAt: failedAt,
+			},
+		}, nil)
+
+		// Act
+		resp, err := controller.ListDeadLetters(context.Background(), &pb.ListDeadLettersRequest{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.ListDeadLettersReply{DeadLetters: []*pb.DeadLetter{
+			{
+				TaskID:   "a",
+				TaskType: "resize",
+				Payload:  "payload",
+				Reason:   "always fails",
+				Attempts: 3,
+				FailedAt: timestamppb.New(failedAt),
+			},
+		}}, resp)
+
+		svc.AssertExpectations(t)
+	})
+}
+
+func Test_GoFlowServiceController_ReplayDeadLetter(t *testing.T) {
+	t.Run("Returns the ID of the replayed task", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		logger.On("Info", "Received replay dead letter: [task-id]").Once()
+		svc.On("ReplayDeadLetter", "task-id").Once().Return("replayed-id", nil)
+
+		// Act
+		resp, err := controller.ReplayDeadLetter(context.Background(), &pb.ReplayDeadLetterRequest{TaskID: "task-id"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "replayed-id", resp.GetId())
+	})
+
+	t.Run("Returns NotFound if there is no dead letter for the task", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		logger.On("Info", mock.Anything).Once()
+		svc.On("ReplayDeadLetter", "task-id").Once().Return("", deadletter.ErrNotFound)
+
+		// Act
+		_, err := controller.ReplayDeadLetter(context.Background(), &pb.ReplayDeadLetterRequest{TaskID: "task-id"})
+
+		// Assert
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func Test_GoFlowServiceController_PurgeDeadLetters(t *testing.T) {
+	t.Run("Returns how many dead letters were purged", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		logger.On("Info", "Received purge dead letters").Once()
+		svc.On("PurgeDeadLetters").Once().Return(2, nil)
+
+		// Act
+		resp, err := controller.PurgeDeadLetters(context.Background(), &pb.PurgeDeadLettersRequest{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), resp.GetPurged())
+	})
+}
+
+func Test_GoFlowServiceController_ListResults(t *testing.T) {
+	t.Run("Returns the page of results matching the filter", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		finishedAfter := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		finishedAt := time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC)
+
+		metadata := map[string]string{"tenant": "acme"}
+		filter := goflow.ResultFilter{
+			TaskType:      "resize",
+			State:         task.StateFailed,
+			FinishedAfter: finishedAfter,
+			Metadata:      metadata,
+		}
+
+		logger.On("Info", "Received list results: [resize] [failed] [cursor]").Once()
+		svc.On("ListResults", filter, "cursor", 10).Once().Return([]task.Result{
+			{
+				TaskID:     "a",
+				TaskType:   "resize",
+				State:      task.StateFailed,
+				ErrMsg:     "failed",
+				Attempt:    2,
+				FinishedAt: finishedAt,
+				Metadata:   metadata,
+			},
+		}, "next", nil)
+
+		// Act
+		resp, err := controller.ListResults(context.Background(), &pb.ListResultsRequest{
+			TaskType:      "resize",
+			State:         "failed",
+			FinishedAfter: timestamppb.New(finishedAfter),
+			Cursor:        "cursor",
+			Limit:         10,
+			Metadata:      metadata,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &pb.ListResultsReply{
+			Results: []*pb.TaskResult{
+				{
+					TaskID:     "a",
+					TaskType:   "resize",
+					State:      "failed",
+					ErrMsg:     "failed",
+					Attempt:    2,
+					FinishedAt: timestamppb.New(finishedAt),
+					Metadata:   metadata,
+				},
+			},
+			NextCursor: "next",
+		}, resp)
+
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Defaults and caps the limit", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		logger.On("Info", mock.Anything).Twice()
+		svc.On("ListResults", goflow.ResultFilter{}, "", defaultListResultsLimit).Once().Return([]task.Result{}, "", nil)
+		svc.On("ListResults", goflow.ResultFilter{}, "", maxListResultsLimit).Once().Return([]task.Result{}, "", nil)
+
+		// Act
+		_, defaultErr := controller.ListResults(context.Background(), &pb.ListResultsRequest{})
+		_, maxErr := controller.ListResults(context.Background(), &pb.ListResultsRequest{Limit: 5000})
+
+		// Assert
+		assert.NoError(t, defaultErr)
+		assert.NoError(t, maxErr)
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Returns InvalidArgument if the state is not terminal", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		logger.On("Info", mock.Anything).Once()
+
+		// Act
+		_, err := controller.ListResults(context.Background(), &pb.ListResultsRequest{State: "running"})
+
+		// Assert
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		svc.AssertNotCalled(t, "ListResults", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Returns Unimplemented if the results store cannot be listed", func(t *testing.T) {
+		// Arrange
+		svc := new(mockGoFlowService)
+		logger := new(log.TestifyMock)
+
+		controller := NewGoFlowServiceController(svc, logger)
+
+		logger.On("Info", mock.Anything).Once()
+		svc.On("ListResults", mock.Anything, mock.Anything, mock.Anything).Once().Return([]task.Result(nil), "", goflow.ErrListUnsupported)
+
+		// Act
+		_, err := controller.ListResults(context.Background(), &pb.ListResultsRequest{})
+
+		// Assert
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+type mockGoFlowService struct {
+	mock.Mock
+}
+
+func (m *mockGoFlowService) PushTask(taskType string, payload any, opts ...goflow.PushOption) (string, error) {
+	args := m.Called(taskType, payload, opts)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockGoFlowService) PushTasks(requests []goflow.PushRequest) ([]string, error) {
+	args := m.Called(requests)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockGoFlowService) GetResult(taskID string) (task.Result, bool, error) {
+	args := m.Called(taskID)
+	return args.Get(0).(task.Result), args.Bool(1), args.Error(2)
+}
+
+func (m *mockGoFlowService) GetStatus(taskID string) (task.Status, bool, error) {
+	args := m.Called(taskID)
+	return args.Get(0).(task.Status), args.Bool(1), args.Error(2)
+}
+
+func (m *mockGoFlowService) SubscribeContext(ctx context.Context, taskIDs ...string) <-chan task.Result {
+	args := m.Called(ctx, taskIDs)
+	return args.Get(0).(<-chan task.Result)
+}
+
+func (m *mockGoFlowService) CancelTask(taskID string) error {
+	args := m.Called(taskID)
+	return args.Error(0)
+}
+
+func (m *mockGoFlowService) AddSchedule(taskType string, payload any, spec string) (string, error) {
+	args := m.Called(taskType, payload, spec)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockGoFlowService) RemoveSchedule(scheduleID string) error {
+	args := m.Called(scheduleID)
+	return args.Error(0)
+}
+
+func (m *mockGoFlowService) ListSchedules() ([]schedule.Schedule, error) {
+	args := m.Called()
+	return args.Get(0).([]schedule.Schedule), args.Error(1)
+}
+
+func (m *mockGoFlowService) ListDeadLetters() ([]deadletter.Entry, error) {
+	args := m.Called()
+	return args.Get(0).([]deadletter.Entry), args.Error(1)
+}
+
+func (m *mockGoFlowService) ReplayDeadLetter(taskID string) (string, error) {
+	args := m.Called(taskID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockGoFlowService) PurgeDeadLetters() (int, error) {
+	args := m.Called()
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockGoFlowService) ListResults(filter goflow.ResultFilter, cursor string, limit int) ([]task.Result, string, error) {
+	args := m.Called(filter, cursor, limit)
+	return args.Get(0).([]task.Result), args.String(1), args.Error(2)
+}
+
+type mockWatchResultStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pb.WatchResultReply
+}
+
+func (m *mockWatchResultStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockWatchResultStream) Send(reply *pb.WatchResultReply) error {
+	m.sent = append(m.sent, reply)
+	return nil
 }
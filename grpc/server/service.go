@@ -1,7 +1,11 @@
 package server
 
 import (
+	"context"
+
 	"github.com/jamesTait-jt/goflow"
+	"github.com/jamesTait-jt/goflow/deadletter"
+	"github.com/jamesTait-jt/goflow/schedule"
 	"github.com/jamesTait-jt/goflow/task"
 )
 
@@ -13,10 +17,54 @@ func NewGoFlowService(gf *goflow.GoFlow) *GoFlowService {
 	return &GoFlowService{gf: gf}
 }
 
-func (gf *GoFlowService) PushTask(taskType string, payload any) (string, error) {
-	return gf.gf.Push(taskType, payload)
+func (gf *GoFlowService) PushTask(taskType string, payload any, opts ...goflow.PushOption) (string, error) {
+	return gf.gf.Push(taskType, payload, opts...)
+}
+
+func (gf *GoFlowService) PushTasks(requests []goflow.PushRequest) ([]string, error) {
+	return gf.gf.PushBatch(requests)
 }
 
 func (gf *GoFlowService) GetResult(taskID string) (task.Result, bool, error) {
 	return gf.gf.GetResult(taskID)
 }
+
+func (gf *GoFlowService) GetStatus(taskID string) (task.Status, bool, error) {
+	return gf.gf.GetStatus(taskID)
+}
+
+func (gf *GoFlowService) SubscribeContext(ctx context.Context, taskIDs ...string) <-chan task.Result {
+	return gf.gf.SubscribeContext(ctx, taskIDs...)
+}
+
+func (gf *GoFlowService) CancelTask(taskID string) error {
+	return gf.gf.Cancel(taskID)
+}
+
+func (gf *GoFlowService) AddSchedule(taskType string, payload any, spec string) (string, error) {
+	return gf.gf.AddSchedule(taskType, payload, spec)
+}
+
+func (gf *GoFlowService) RemoveSchedule(scheduleID string) error {
+	return gf.gf.RemoveSchedule(scheduleID)
+}
+
+func (gf *GoFlowService) ListSchedules() ([]schedule.Schedule, error) {
+	return gf.gf.ListSchedules()
+}
+
+func (gf *GoFlowService) ListDeadLetters() ([]deadletter.Entry, error) {
+	return gf.gf.ListDeadLetters()
+}
+
+func (gf *GoFlowService) ReplayDeadLetter(taskID string) (string, error) {
+	return gf.gf.ReplayDeadLetter(taskID)
+}
+
+func (gf *GoFlowService) PurgeDeadLetters() (int, error) {
+	return gf.gf.PurgeDeadLetters()
+}
+
+func (gf *GoFlowService) ListResults(filter goflow.ResultFilter, cursor string, limit int) ([]task.Result, string, error) {
+	return gf.gf.ListResults(filter, cursor, limit)
+}
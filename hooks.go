@@ -0,0 +1,70 @@
+package goflow
+
+import (
+	"log"
+
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+// hooks are the user callbacks fired as tasks move through their lifecycle.
+type hooks struct {
+	onResult      []func(task.Result)
+	onFailure     []func(task.Result)
+	onTaskStarted []func(task.Status)
+}
+
+// fireHooks runs the hooks that apply to a result read from the results broker.
+func (gf *GoFlow) fireHooks(result task.Result) {
+	status := result.Status()
+
+	switch {
+	case status.State == task.StateRunning:
+		for _, fn := range gf.hooks.onTaskStarted {
+			runHook(gf, "OnTaskStarted", fn, status)
+		}
+
+	case status.State.Terminal():
+		for _, fn := range gf.hooks.onResult {
+			runHook(gf, "OnResult", fn, result)
+		}
+
+		if status.State == task.StateFailed {
+			for _, fn := range gf.hooks.onFailure {
+				runHook(gf, "OnFailure", fn, result)
+			}
+		}
+	}
+}
+
+// watchCallback calls callback with the result of the task once it is available.
+func (gf *GoFlow) watchCallback(taskID string, callback func(task.Result)) {
+	results := gf.Subscribe(taskID)
+
+	gf.hooksWG.Add(1)
+
+	go func() {
+		defer gf.hooksWG.Done()
+
+		for result := range results {
+			runHook(gf, "callback", callback, result)
+		}
+	}()
+}
+
+// runHook calls fn in its own goroutine so that a slow hook cannot hold up the
+// results pipeline, and recovers if it panics so that it cannot take GoFlow down.
+func runHook[T any](gf *GoFlow, name string, fn func(T), arg T) {
+	gf.hooksWG.Add(1)
+
+	go func() {
+		defer gf.hooksWG.Done()
+
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("%s hook panicked: %v", name, r)
+			}
+		}()
+
+		fn(arg)
+	}()
+}
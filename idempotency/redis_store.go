@@ -0,0 +1,69 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type redisClient interface {
+	Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd
+}
+
+// claimScript returns the task ID stored at KEYS[1], first storing ARGV[1] there
+// with a TTL of ARGV[2] milliseconds if the key does not exist.
+const claimScript = `
+local existing = redis.call('GET', KEYS[1])
+if existing then
+	return existing
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return ARGV[1]
+`
+
+// releaseScript deletes KEYS[1] if it still holds the task ID ARGV[1].
+const releaseScript = `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`
+
+// RedisStore is a Store shared by every process connected to the same Redis, so
+// that every server replica sees the same keys. Each idempotency key is stored as
+// its own Redis key, which expires at the end of its window.
+type RedisStore struct {
+	client redisClient
+	prefix string
+}
+
+// NewRedisStore creates a RedisStore that keeps each idempotency key under
+// prefix + ":" + key.
+func NewRedisStore(client redisClient, prefix string) *RedisStore {
+	return &RedisStore{client: client, prefix: prefix}
+}
+
+func (r *RedisStore) Claim(ctx context.Context, key, taskID string, window time.Duration) (string, bool, error) {
+	if window <= 0 {
+		return "", false, ErrInvalidWindow
+	}
+
+	// Redis rejects a TTL of 0, so windows under a millisecond are rounded up
+	windowMillis := max(1, window.Milliseconds())
+
+	recorded, err := r.client.Eval(ctx, claimScript, []string{r.redisKey(key)}, taskID, windowMillis).Text()
+	if err != nil {
+		return "", false, err
+	}
+
+	return recorded, recorded == taskID, nil
+}
+
+func (r *RedisStore) Release(ctx context.Context, key, taskID string) error {
+	return r.client.Eval(ctx, releaseScript, []string{r.redisKey(key)}, taskID).Err()
+}
+
+func (r *RedisStore) redisKey(key string) string {
+	return r.prefix + ":" + key
+}
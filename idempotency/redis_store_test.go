@@ -0,0 +1,133 @@
+//go:build unit
+
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_RedisStore_Claim(t *testing.T) {
+	tests := []struct {
+		name     string
+		recorded string
+		claimed  bool
+	}{
+		{"Claims a key that is not recorded", "task-id", true},
+		{"Returns the task already recorded under the key", "original-id", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			client := new(mockRedisClient)
+			store := NewRedisStore(client, "idempotency")
+
+			ctx := context.Background()
+
+			cmd := redis.NewCmd(ctx)
+			cmd.SetVal(tt.recorded)
+			client.On("Eval", ctx, claimScript, []string{"idempotency:key"}, []any{"task-id", int64(60000)}).
+				Once().
+				Return(cmd)
+
+			// Act
+			taskID, claimed, err := store.Claim(ctx, "key", "task-id", time.Minute)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.recorded, taskID)
+			assert.Equal(t, tt.claimed, claimed)
+			client.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns an error if redis fails", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		store := NewRedisStore(client, "idempotency")
+
+		ctx := context.Background()
+
+		cmd := redis.NewCmd(ctx)
+		cmd.SetErr(redis.ErrClosed)
+		client.On("Eval", ctx, claimScript, mock.Anything, mock.Anything).Once().Return(cmd)
+
+		// Act
+		_, _, err := store.Claim(ctx, "key", "task-id", time.Minute)
+
+		// Assert
+		assert.ErrorIs(t, err, redis.ErrClosed)
+	})
+
+	t.Run("Returns an error for a window that is not positive without calling redis", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		store := NewRedisStore(client, "idempotency")
+
+		// Act
+		_, claimed, err := store.Claim(context.Background(), "key", "task-id", 0)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidWindow)
+		assert.False(t, claimed)
+		client.AssertNotCalled(t, "Eval", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Rounds a window under a millisecond up to one", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		store := NewRedisStore(client, "idempotency")
+
+		ctx := context.Background()
+
+		cmd := redis.NewCmd(ctx)
+		cmd.SetVal("task-id")
+		client.On("Eval", ctx, claimScript, []string{"idempotency:key"}, []any{"task-id", int64(1)}).
+			Once().
+			Return(cmd)
+
+		// Act
+		_, claimed, err := store.Claim(ctx, "key", "task-id", time.Microsecond)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+		client.AssertExpectations(t)
+	})
+}
+
+func Test_RedisStore_Release(t *testing.T) {
+	t.Run("Releases the key if it still holds the task", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		store := NewRedisStore(client, "idempotency")
+
+		ctx := context.Background()
+
+		cmd := redis.NewCmd(ctx)
+		cmd.SetVal(int64(1))
+		client.On("Eval", ctx, releaseScript, []string{"idempotency:key"}, []any{"task-id"}).Once().Return(cmd)
+
+		// Act
+		err := store.Release(ctx, "key", "task-id")
+
+		// Assert
+		assert.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+}
+
+type mockRedisClient struct {
+	mock.Mock
+}
+
+func (m *mockRedisClient) Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd {
+	called := m.Called(ctx, script, keys, args)
+	return called.Get(0).(*redis.Cmd)
+}
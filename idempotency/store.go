@@ -0,0 +1,79 @@
+// Package idempotency records the task pushed for each idempotency key, so that a
+// push repeated with the same key returns the original task instead of creating a
+// duplicate.
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrInvalidWindow is returned when claiming a key for a window that is not positive.
+var ErrInvalidWindow = errors.New("idempotency window must be positive")
+
+// Store maps idempotency keys to task IDs for a limited window. Several GoFlow
+// instances may share a store, so Claim must be atomic: it is how a push claims
+// the right to enqueue a task for its key.
+type Store interface {
+	// Claim records taskID under key for the given window, unless a task is already
+	// recorded under the key. It returns the ID of the task recorded under the key
+	// and whether it was claimed by this call, or ErrInvalidWindow if the window is
+	// not positive.
+	Claim(ctx context.Context, key, taskID string, window time.Duration) (string, bool, error)
+
+	// Release removes key if taskID is still the task recorded under it, so that
+	// the key can be reused after a push fails.
+	Release(ctx context.Context, key, taskID string) error
+}
+
+type claim struct {
+	taskID    string
+	expiresAt time.Time
+}
+
+// InMemoryStore is a Store for a single process. Expired keys are removed when
+// they are next claimed.
+type InMemoryStore struct {
+	claims map[string]claim
+	mu     sync.Mutex
+	now    func() time.Time
+}
+
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		claims: make(map[string]claim),
+		now:    time.Now,
+	}
+}
+
+func (s *InMemoryStore) Claim(_ context.Context, key, taskID string, window time.Duration) (string, bool, error) {
+	if window <= 0 {
+		return "", false, ErrInvalidWindow
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	if existing, ok := s.claims[key]; ok && now.Before(existing.expiresAt) {
+		return existing.taskID, false, nil
+	}
+
+	s.claims[key] = claim{taskID: taskID, expiresAt: now.Add(window)}
+
+	return taskID, true, nil
+}
+
+func (s *InMemoryStore) Release(_ context.Context, key, taskID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.claims[key]; ok && existing.taskID == taskID {
+		delete(s.claims, key)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+//go:build unit
+
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InMemoryStore_Claim(t *testing.T) {
+	t.Run("Returns the original task for a key claimed within the window", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryStore()
+		ctx := context.Background()
+
+		// Act
+		firstID, firstClaimed, firstErr := s.Claim(ctx, "key", "first", time.Minute)
+		secondID, secondClaimed, secondErr := s.Claim(ctx, "key", "second", time.Minute)
+
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.NoError(t, secondErr)
+		assert.Equal(t, "first", firstID)
+		assert.True(t, firstClaimed)
+		assert.Equal(t, "first", secondID)
+		assert.False(t, secondClaimed)
+	})
+
+	t.Run("Claims a key again once its window has passed", func(t *testing.T) {
+		// Arrange
+		now := time.Now()
+
+		s := NewInMemoryStore()
+		s.now = func() time.Time { return now }
+
+		ctx := context.Background()
+		_, _, _ = s.Claim(ctx, "key", "first", time.Minute)
+
+		now = now.Add(time.Minute)
+
+		// Act
+		taskID, claimed, err := s.Claim(ctx, "key", "second", time.Minute)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "second", taskID)
+		assert.True(t, claimed)
+	})
+
+	t.Run("Returns an error for a window that is not positive", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryStore()
+		ctx := context.Background()
+
+		// Act
+		_, claimed, err := s.Claim(ctx, "key", "first", 0)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidWindow)
+		assert.False(t, claimed)
+	})
+}
+
+func Test_InMemoryStore_Release(t *testing.T) {
+	t.Run("Frees the key for the task that claimed it", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryStore()
+		ctx := context.Background()
+
+		_, _, _ = s.Claim(ctx, "key", "first", time.Minute)
+
+		// Act
+		err := s.Release(ctx, "key", "first")
+		taskID, claimed, _ := s.Claim(ctx, "key", "second", time.Minute)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "second", taskID)
+		assert.True(t, claimed)
+	})
+
+	t.Run("Leaves a key claimed by another task", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryStore()
+		ctx := context.Background()
+
+		_, _, _ = s.Claim(ctx, "key", "first", time.Minute)
+
+		// Act
+		err := s.Release(ctx, "key", "other")
+		taskID, claimed, _ := s.Claim(ctx, "key", "second", time.Minute)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "first", taskID)
+		assert.False(t, claimed)
+	})
+}
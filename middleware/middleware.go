@@ -0,0 +1,68 @@
+// Package middleware provides task.Middleware for common concerns, to be passed to
+// goflow.WithMiddleware in local mode or enabled with the worker pool's -middleware
+// flag.
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/pkg/log"
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+// Recover turns a panic in the handler into a failed result. The worker pool already
+// recovers panics, so Recover is for middleware further out that should see the
+// panic as a failure, or for handlers run outside the pool. Recovered panics are
+// reported with task.ReportPanic, so they still count towards the pool's quarantine.
+func Recover() task.Middleware {
+	return func(next task.Handler) task.Handler {
+		return func(ctx context.Context, t task.Task) (result task.Result) {
+			defer func() {
+				if r := recover(); r != nil {
+					task.ReportPanic(ctx, r, debug.Stack())
+
+					result = task.Result{TaskID: t.ID, ErrMsg: fmt.Sprintf("handler panicked: %v", r)}
+				}
+			}()
+
+			return next(ctx, t)
+		}
+	}
+}
+
+// Timing calls observe with how long the handler took to run each task, for example
+// to record it as a metric.
+func Timing(observe func(t task.Task, elapsed time.Duration)) task.Middleware {
+	return func(next task.Handler) task.Handler {
+		return func(ctx context.Context, t task.Task) task.Result {
+			start := time.Now()
+			result := next(ctx, t)
+
+			observe(t, time.Since(start))
+
+			return result
+		}
+	}
+}
+
+// Logging logs when each task starts and whether it succeeded or failed.
+func Logging(logger log.Logger) task.Middleware {
+	return func(next task.Handler) task.Handler {
+		return func(ctx context.Context, t task.Task) task.Result {
+			logger.Info(fmt.Sprintf("Running task [%s] of type [%s]", t.ID, t.Type))
+
+			result := next(ctx, t)
+
+			if result.ErrMsg != "" {
+				logger.Error(fmt.Sprintf("Task [%s] failed: %s", t.ID, result.ErrMsg))
+			} else {
+				logger.Info(fmt.Sprintf("Task [%s] succeeded", t.ID))
+			}
+
+			return result
+		}
+	}
+}
@@ -0,0 +1,127 @@
+//go:build unit
+
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/pkg/log"
+	"github.com/jamesTait-jt/goflow/task"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Recover(t *testing.T) {
+	t.Run("Turns a panic into a failed result", func(t *testing.T) {
+		// Arrange
+		handler := func(_ context.Context, _ task.Task) task.Result {
+			panic("boom")
+		}
+
+		// Act
+		result := Recover()(handler)(context.Background(), task.Task{ID: "task-id"})
+
+		// Assert
+		assert.Equal(t, task.Result{TaskID: "task-id", ErrMsg: "handler panicked: boom"}, result)
+	})
+
+	t.Run("Reports the panic to the worker pool", func(t *testing.T) {
+		// Arrange
+		handler := func(_ context.Context, _ task.Task) task.Result {
+			panic("boom")
+		}
+
+		var reported any
+
+		ctx := task.WithPanicReporter(context.Background(), func(recovered any, _ []byte) {
+			reported = recovered
+		})
+
+		// Act
+		_ = Recover()(handler)(ctx, task.Task{ID: "task-id"})
+
+		// Assert
+		assert.Equal(t, "boom", reported)
+	})
+
+	t.Run("Returns the result of a handler that does not panic", func(t *testing.T) {
+		// Arrange
+		handler := func(_ context.Context, _ task.Task) task.Result {
+			return task.Result{Payload: "done"}
+		}
+
+		// Act
+		result := Recover()(handler)(context.Background(), task.Task{})
+
+		// Assert
+		assert.Equal(t, task.Result{Payload: "done"}, result)
+	})
+}
+
+func Test_Timing(t *testing.T) {
+	t.Run("Observes how long the handler took", func(t *testing.T) {
+		// Arrange
+		var (
+			observedTask    task.Task
+			observedElapsed time.Duration
+		)
+
+		observe := func(t task.Task, elapsed time.Duration) {
+			observedTask = t
+			observedElapsed = elapsed
+		}
+
+		handler := func(_ context.Context, _ task.Task) task.Result {
+			time.Sleep(5 * time.Millisecond)
+
+			return task.Result{Payload: "done"}
+		}
+
+		tsk := task.Task{ID: "task-id", Type: "test_task"}
+
+		// Act
+		result := Timing(observe)(handler)(context.Background(), tsk)
+
+		// Assert
+		assert.Equal(t, task.Result{Payload: "done"}, result)
+		assert.Equal(t, tsk, observedTask)
+		assert.GreaterOrEqual(t, observedElapsed, 5*time.Millisecond)
+	})
+}
+
+func Test_Logging(t *testing.T) {
+	t.Run("Logs the start and success of a task", func(t *testing.T) {
+		// Arrange
+		logger := new(log.TestifyMock)
+		logger.On("Info", "Running task [task-id] of type [test_task]").Once()
+		logger.On("Info", "Task [task-id] succeeded").Once()
+
+		handler := func(_ context.Context, _ task.Task) task.Result {
+			return task.Result{}
+		}
+
+		// Act
+		Logging(logger)(handler)(context.Background(), task.Task{ID: "task-id", Type: "test_task"})
+
+		// Assert
+		logger.AssertExpectations(t)
+	})
+
+	t.Run("Logs the error of a failed task", func(t *testing.T) {
+		// Arrange
+		logger := new(log.TestifyMock)
+		logger.On("Info", "Running task [task-id] of type [test_task]").Once()
+		logger.On("Error", "Task [task-id] failed: boom").Once()
+
+		handler := func(_ context.Context, _ task.Task) task.Result {
+			return task.Result{ErrMsg: "boom"}
+		}
+
+		// Act
+		Logging(logger)(handler)(context.Background(), task.Task{ID: "task-id", Type: "test_task"})
+
+		// Assert
+		logger.AssertExpectations(t)
+	})
+}
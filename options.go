@@ -1,7 +1,15 @@
 package goflow
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/deadletter"
+	"github.com/jamesTait-jt/goflow/idempotency"
 	"github.com/jamesTait-jt/goflow/pkg/store"
+	"github.com/jamesTait-jt/goflow/ratelimit"
+	"github.com/jamesTait-jt/goflow/retry"
+	"github.com/jamesTait-jt/goflow/schedule"
 	"github.com/jamesTait-jt/goflow/task"
 )
 
@@ -9,6 +17,8 @@ var (
 	defaultNumWorkers            = 5
 	defaultTaskQueueBufferSize   = 0
 	defaultResultQueueBufferSize = 0
+	defaultResultPollInterval    = time.Second
+	defaultIdempotencyWindow     = 24 * time.Hour
 )
 
 type Option interface {
@@ -20,6 +30,22 @@ type options struct {
 	taskQueueBufferSize   int
 	resultQueueBufferSize int
 	resultsStore          KVStore[string, task.Result]
+	resultTTL             time.Duration
+	statusStore           KVStore[string, task.Status]
+	groupStore            KVStore[string, Group]
+	retryPolicies         map[string]retry.Policy
+	rateLimits            map[string]ratelimit.Limit
+	concurrencyLimits     map[string]int
+	middleware            []task.Middleware
+	panicThreshold        int
+	quarantinePeriod      time.Duration
+	defaultTaskTimeout    time.Duration
+	scheduleStore         schedule.Store
+	resultPollInterval    time.Duration
+	hooks                 hooks
+	idempotencyStore      idempotency.Store
+	idempotencyWindow     time.Duration
+	deadLetterQueue       deadletter.Queue
 }
 
 func defaultOptions() options {
@@ -28,6 +54,16 @@ func defaultOptions() options {
 		taskQueueBufferSize:   defaultTaskQueueBufferSize,
 		resultQueueBufferSize: defaultResultQueueBufferSize,
 		resultsStore:          store.NewInMemoryKVStore[string, task.Result](),
+		statusStore:           store.NewInMemoryKVStore[string, task.Status](),
+		groupStore:            store.NewInMemoryKVStore[string, Group](),
+		retryPolicies:         map[string]retry.Policy{},
+		rateLimits:            map[string]ratelimit.Limit{},
+		concurrencyLimits:     map[string]int{},
+		scheduleStore:         schedule.NewInMemoryStore(),
+		resultPollInterval:    defaultResultPollInterval,
+		idempotencyStore:      idempotency.NewInMemoryStore(),
+		idempotencyWindow:     defaultIdempotencyWindow,
+		deadLetterQueue:       deadletter.NewInMemoryQueue(),
 	}
 }
 
@@ -86,3 +122,283 @@ func (r resultsStoreOption) apply(opts *options) {
 func WithResultsStore(resultsStore KVStore[string, task.Result]) Option {
 	return resultsStoreOption{ResultsStore: resultsStore}
 }
+
+type resultTTLOption struct {
+	ResultTTL time.Duration
+}
+
+func (r resultTTLOption) apply(opts *options) {
+	opts.resultTTL = r.ResultTTL
+}
+
+// WithResultTTL allows you to set how long results are kept in the results store
+// before they expire, after which Get no longer finds them. Results are stored with
+// KVStore.PutWithTTL, so the store decides when expired results are removed; the
+// default in-memory store only removes them when they are next read unless it was
+// created with store.WithSweepInterval. Task statuses and groups expire with the
+// same TTL, measured from when they were last updated. Defaults to 0, meaning
+// results never expire.
+func WithResultTTL(ttl time.Duration) Option {
+	return resultTTLOption{ResultTTL: ttl}
+}
+
+type statusStoreOption struct {
+	StatusStore KVStore[string, task.Status]
+}
+
+func (s statusStoreOption) apply(opts *options) {
+	opts.statusStore = s.StatusStore
+}
+
+// WithStatusStore allows you to inject your own store for task statuses. Anything
+// that implements the KVStore interface is viable.
+func WithStatusStore(statusStore KVStore[string, task.Status]) Option {
+	return statusStoreOption{StatusStore: statusStore}
+}
+
+type groupStoreOption struct {
+	GroupStore KVStore[string, Group]
+}
+
+func (g groupStoreOption) apply(opts *options) {
+	opts.groupStore = g.GroupStore
+}
+
+// WithGroupStore allows you to inject your own store for task groups. Anything that
+// implements the KVStore interface is viable.
+func WithGroupStore(groupStore KVStore[string, Group]) Option {
+	return groupStoreOption{GroupStore: groupStore}
+}
+
+type retryPolicyOption struct {
+	TaskType string
+	Policy   retry.Policy
+}
+
+func (r retryPolicyOption) apply(opts *options) {
+	opts.retryPolicies[r.TaskType] = r.Policy
+}
+
+// WithRetryPolicy allows you to retry failed tasks of the given type. Only the final
+// outcome of a task is written to the results store. Can be passed multiple times to
+// configure several task types. Has no effect if running in distributed mode, where
+// retries are configured on the worker pool.
+func WithRetryPolicy(taskType string, policy retry.Policy) Option {
+	return retryPolicyOption{TaskType: taskType, Policy: policy}
+}
+
+type rateLimitOption struct {
+	TaskType string
+	Limit    ratelimit.Limit
+}
+
+func (r rateLimitOption) apply(opts *options) {
+	opts.rateLimits[r.TaskType] = r.Limit
+}
+
+// WithRateLimit allows you to limit how often tasks of the given type are executed.
+// Tasks over the limit are delayed until a token is available rather than failed.
+// Can be passed multiple times to configure several task types. Has no effect if
+// running in distributed mode, where rate limits are configured on the worker pool.
+// Panics if the limit's rate is not positive.
+func WithRateLimit(taskType string, limit ratelimit.Limit) Option {
+	if err := limit.Validate(); err != nil {
+		panic(fmt.Sprintf("goflow: invalid rate limit for task type %q: %v", taskType, err))
+	}
+
+	return rateLimitOption{TaskType: taskType, Limit: limit}
+}
+
+type concurrencyLimitOption struct {
+	TaskType string
+	Limit    int
+}
+
+func (c concurrencyLimitOption) apply(opts *options) {
+	opts.concurrencyLimits[c.TaskType] = c.Limit
+}
+
+// WithConcurrencyLimit allows you to cap how many tasks of the given type run at
+// once, so that a slow task type cannot occupy every worker. Tasks over the cap are
+// held until a slot is free rather than dropped. Can be passed multiple times to
+// configure several task types. Has no effect if running in distributed mode, where concurrency limits
+// are configured on the worker pool.
+func WithConcurrencyLimit(taskType string, limit int) Option {
+	return concurrencyLimitOption{TaskType: taskType, Limit: limit}
+}
+
+type middlewareOption struct {
+	Middleware []task.Middleware
+}
+
+func (m middlewareOption) apply(opts *options) {
+	opts.middleware = append(opts.middleware, m.Middleware...)
+}
+
+// WithMiddleware wraps every handler with the middleware, such as the built-ins in
+// the middleware package. The first middleware is the outermost. Can be passed
+// multiple times, in which case the middleware is appended. Has no effect if running
+// in distributed mode, where middleware is configured on the worker pool.
+func WithMiddleware(middleware ...task.Middleware) Option {
+	return middlewareOption{Middleware: middleware}
+}
+
+type panicQuarantineOption struct {
+	Threshold int
+	Period    time.Duration
+}
+
+func (p panicQuarantineOption) apply(opts *options) {
+	opts.panicThreshold = p.Threshold
+	opts.quarantinePeriod = p.Period
+}
+
+// WithPanicQuarantine allows you to quarantine a task type once its handler has
+// panicked threshold times. Tasks of a quarantined type fail without being run until
+// the period has passed. Panicking handlers never crash the worker pool, whether or
+// not this is set. Has no effect if running in distributed mode, where quarantine is
+// configured on the worker pool.
+func WithPanicQuarantine(threshold int, period time.Duration) Option {
+	return panicQuarantineOption{Threshold: threshold, Period: period}
+}
+
+type defaultTaskTimeoutOption struct {
+	DefaultTaskTimeout time.Duration
+}
+
+func (d defaultTaskTimeoutOption) apply(opts *options) {
+	opts.defaultTaskTimeout = d.DefaultTaskTimeout
+}
+
+// WithDefaultTaskTimeout allows you to set the timeout applied to pushed tasks that
+// do not set their own with WithTaskTimeout. Defaults to no timeout.
+func WithDefaultTaskTimeout(timeout time.Duration) Option {
+	return defaultTaskTimeoutOption{DefaultTaskTimeout: timeout}
+}
+
+type scheduleStoreOption struct {
+	ScheduleStore schedule.Store
+}
+
+func (s scheduleStoreOption) apply(opts *options) {
+	opts.scheduleStore = s.ScheduleStore
+}
+
+// WithScheduleStore allows you to inject your own store for recurring schedules.
+// Defaults to an in-memory store. GoFlow instances sharing a store, such as
+// schedule.RedisStore, fire each run of a schedule only once between them.
+func WithScheduleStore(scheduleStore schedule.Store) Option {
+	return scheduleStoreOption{ScheduleStore: scheduleStore}
+}
+
+type resultPollIntervalOption struct {
+	ResultPollInterval time.Duration
+}
+
+func (r resultPollIntervalOption) apply(opts *options) {
+	opts.resultPollInterval = r.ResultPollInterval
+}
+
+// WithResultPollInterval allows you to set how often Await and Subscribe check the
+// results store for results that were not persisted by this GoFlow instance, such
+// as those written by another instance sharing the results store. Defaults to one
+// second.
+func WithResultPollInterval(interval time.Duration) Option {
+	return resultPollIntervalOption{ResultPollInterval: interval}
+}
+
+type idempotencyStoreOption struct {
+	IdempotencyStore idempotency.Store
+}
+
+func (i idempotencyStoreOption) apply(opts *options) {
+	opts.idempotencyStore = i.IdempotencyStore
+}
+
+// WithIdempotencyStore allows you to inject your own store for the idempotency keys
+// set with WithIdempotencyKey. Defaults to an in-memory store. GoFlow instances
+// sharing a store, such as idempotency.RedisStore, suppress each other's duplicates.
+func WithIdempotencyStore(idempotencyStore idempotency.Store) Option {
+	return idempotencyStoreOption{IdempotencyStore: idempotencyStore}
+}
+
+type idempotencyWindowOption struct {
+	IdempotencyWindow time.Duration
+}
+
+func (i idempotencyWindowOption) apply(opts *options) {
+	opts.idempotencyWindow = i.IdempotencyWindow
+}
+
+// WithIdempotencyWindow allows you to set how long an idempotency key is remembered
+// after the task it was pushed with. Pushing with the same key after the window has
+// passed creates a new task. Defaults to 24 hours. Panics if the window is not
+// positive.
+func WithIdempotencyWindow(window time.Duration) Option {
+	if window <= 0 {
+		panic(fmt.Sprintf("goflow: %v, got %s", idempotency.ErrInvalidWindow, window))
+	}
+
+	return idempotencyWindowOption{IdempotencyWindow: window}
+}
+
+type deadLetterQueueOption struct {
+	DeadLetterQueue deadletter.Queue
+}
+
+func (d deadLetterQueueOption) apply(opts *options) {
+	opts.deadLetterQueue = d.DeadLetterQueue
+}
+
+// WithDeadLetterQueue allows you to inject your own queue for dead letters: tasks
+// with no registered handler, and tasks that failed on their final attempt. Defaults
+// to an in-memory queue. In distributed mode, GoFlow should share a queue, such as
+// deadletter.RedisQueue, with the worker pool so that it can list and replay the
+// tasks the worker pool dead-letters.
+func WithDeadLetterQueue(queue deadletter.Queue) Option {
+	return deadLetterQueueOption{DeadLetterQueue: queue}
+}
+
+type onResultOption struct {
+	OnResult func(task.Result)
+}
+
+func (o onResultOption) apply(opts *options) {
+	opts.hooks.onResult = append(opts.hooks.onResult, o.OnResult)
+}
+
+// WithOnResult registers a hook that is called with the result of every task once
+// it has finished, whether it succeeded or failed. Hooks run in their own goroutine,
+// so they do not hold up other results, and a panicking hook is recovered and
+// logged. Can be passed multiple times to register several hooks.
+func WithOnResult(fn func(task.Result)) Option {
+	return onResultOption{OnResult: fn}
+}
+
+type onFailureOption struct {
+	OnFailure func(task.Result)
+}
+
+func (o onFailureOption) apply(opts *options) {
+	opts.hooks.onFailure = append(opts.hooks.onFailure, o.OnFailure)
+}
+
+// WithOnFailure registers a hook that is called with the result of every task that
+// fails after any retries. Runs in the same way as WithOnResult.
+func WithOnFailure(fn func(task.Result)) Option {
+	return onFailureOption{OnFailure: fn}
+}
+
+type onTaskStartedOption struct {
+	OnTaskStarted func(task.Status)
+}
+
+func (o onTaskStartedOption) apply(opts *options) {
+	opts.hooks.onTaskStarted = append(opts.hooks.onTaskStarted, o.OnTaskStarted)
+}
+
+// WithOnTaskStarted registers a hook that is called each time a worker starts an
+// attempt at a task. Runs in the same way as WithOnResult.
+func WithOnTaskStarted(fn func(task.Status)) Option {
+	return onTaskStartedOption{OnTaskStarted: fn}
+}
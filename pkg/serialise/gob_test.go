@@ -1,3 +1,76 @@
 //go:build unit
 
 package serialise
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/task"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GobSerialiser(t *testing.T) {
+	t.Run("Round trips a task and its result with their metadata", func(t *testing.T) {
+		// Arrange
+		metadata := map[string]string{"trace_id": "abc", "tenant": "acme"}
+
+		sentTask := task.Task{ID: "task-id", Type: "resize", Payload: "payload", Metadata: metadata}
+		sentResult := task.Result{TaskID: "task-id", State: task.StateSucceeded, FinishedAt: time.Now().UTC(), Metadata: metadata}
+
+		taskSerialiser := NewGobSerialiser[task.Task]()
+		resultSerialiser := NewGobSerialiser[task.Result]()
+
+		// Act
+		encodedTask, taskErr := taskSerialiser.Serialise(sentTask)
+		decodedTask, decodeTaskErr := taskSerialiser.Deserialise(encodedTask)
+
+		encodedResult, resultErr := resultSerialiser.Serialise(sentResult)
+		decodedResult, decodeResultErr := resultSerialiser.Deserialise(encodedResult)
+
+		// Assert
+		assert.NoError(t, taskErr)
+		assert.NoError(t, decodeTaskErr)
+		assert.Equal(t, sentTask, decodedTask)
+
+		assert.NoError(t, resultErr)
+		assert.NoError(t, decodeResultErr)
+		assert.Equal(t, sentResult, decodedResult)
+	})
+
+	t.Run("Round trips a workflow fan-in payload without importing workflow", func(t *testing.T) {
+		// Arrange
+		sent := task.Task{ID: "task-id", Type: "merge", Payload: map[string]any{"left": 1, "right": "two"}}
+
+		serialiser := NewGobSerialiser[task.Task]()
+
+		// Act
+		encoded, err := serialiser.Serialise(sent)
+		decoded, decodeErr := serialiser.Deserialise(encoded)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, decodeErr)
+		assert.Equal(t, sent, decoded)
+	})
+
+	t.Run("Round trips a group reducer payload without importing goflow", func(t *testing.T) {
+		// Arrange
+		sent := task.Task{
+			ID:      "task-id",
+			Type:    "reduce",
+			Payload: []task.Result{{TaskID: "member", State: task.StateSucceeded, Payload: "done"}},
+		}
+
+		serialiser := NewGobSerialiser[task.Task]()
+
+		// Act
+		encoded, err := serialiser.Serialise(sent)
+		decoded, decodeErr := serialiser.Deserialise(encoded)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, decodeErr)
+		assert.Equal(t, sent, decoded)
+	})
+}
@@ -0,0 +1,575 @@
+package store
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/pkg/log"
+)
+
+// Each record in a FileKVStore's log is framed as
+//
+//	payload length (uint32) | CRC-32C of payload (uint32) | payload
+//
+// and each payload is
+//
+//	op (byte) | expiry in unix nanoseconds, or 0 (int64) | key length (uint32) | key | value
+//
+// with every integer big-endian.
+const (
+	opPut    byte = 1
+	opDelete byte = 2
+
+	recordHeaderSize  = 8
+	payloadHeaderSize = 13
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// errTornRecord reports a record at the end of the log that was cut short, fails its
+// checksum or was left as zeros, which is what a crash part way through appending it
+// leaves behind.
+var errTornRecord = errors.New("torn record")
+
+// errCorruptRecord reports a record that cannot be read but is not the last in the
+// log, so it cannot have been torn by a crash.
+var errCorruptRecord = errors.New("corrupt record before the end of the log")
+
+type fileEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// FileKVStore is a key-value store that survives restarts, for running GoFlow in a
+// single process. Every value is held in memory, and every put and delete is
+// appended to a log file and synced to disk before it returns.
+//
+// When the store is opened, the log is replayed to rebuild its contents. A record
+// torn by a crash part way through writing it can only be the last in the log, so
+// the log is truncated to the last whole record and the write is lost. Any other
+// record that cannot be read fails the open rather than losing the records after
+// it. Overwritten,
+// deleted and expired values are dropped when the log is compacted, which rewrites
+// it to a new file that then replaces it (see WithCompactAfter).
+//
+// Values must be encodable by the given encoder, so a handler registry can only be
+// persisted with an encoder that maps each handler to a name and back.
+//
+// The KVStore interface has no way of returning errors, so failures to write to
+// the log are logged, and the value is left unchanged.
+type FileKVStore[V any] struct {
+	path         string
+	file         *os.File
+	size         int64
+	records      int
+	entries      map[string]fileEntry[V]
+	index        *keyIndex
+	encoder      Encoder[V]
+	logger       log.Logger
+	compactAfter int
+	mu           sync.Mutex
+	now          func() time.Time
+}
+
+// OpenFileKVStore opens the store logged to the file at path, creating the file if
+// it does not exist.
+func OpenFileKVStore[V any](path string, encoder Encoder[V], opts ...PersistentOption) (*FileKVStore[V], error) {
+	options := defaultPersistentOptions()
+
+	for _, o := range opts {
+		o.apply(&options)
+	}
+
+	path = filepath.Clean(path)
+
+	// A compaction interrupted by a crash leaves its unfinished log behind
+	if err := os.Remove(compactionPath(path)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	_, err := os.Stat(path)
+	created := errors.Is(err, fs.ErrNotExist)
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	// A new log only survives a crash once its directory entry is synced
+	if created {
+		if err := syncDir(filepath.Dir(path)); err != nil {
+			_ = file.Close()
+
+			return nil, err
+		}
+	}
+
+	kv := &FileKVStore[V]{
+		path:         path,
+		file:         file,
+		entries:      make(map[string]fileEntry[V]),
+		encoder:      encoder,
+		logger:       options.logger,
+		compactAfter: options.compactAfter,
+		now:          time.Now,
+	}
+
+	if err := kv.load(); err != nil {
+		_ = file.Close()
+
+		return nil, fmt.Errorf("failed to load %s: %w", path, err)
+	}
+
+	return kv, nil
+}
+
+// Put stores the value under the given key with no expiry.
+func (kv *FileKVStore[V]) Put(k string, v V) {
+	kv.PutWithTTL(k, v, 0)
+}
+
+// PutWithTTL stores the value under the given key until the TTL has passed. A TTL
+// of 0 or less means the value never expires.
+func (kv *FileKVStore[V]) PutWithTTL(k string, v V, ttl time.Duration) {
+	encoded, err := kv.encoder.Serialise(v)
+	if err != nil {
+		kv.logger.Error(fmt.Sprintf("failed to encode value for key %s: %v", k, err))
+		return
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = kv.now().Add(ttl)
+	}
+
+	if err := kv.append(opPut, k, expiresAt, encoded); err != nil {
+		kv.logger.Error(fmt.Sprintf("failed to put key %s: %v", k, err))
+		return
+	}
+
+	if _, exists := kv.entries[k]; !exists && kv.index != nil {
+		kv.index.add(k)
+	}
+
+	kv.entries[k] = fileEntry[V]{value: v, expiresAt: expiresAt}
+
+	kv.compactIfStale()
+}
+
+func (kv *FileKVStore[V]) Get(k string) (V, bool) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	entry, ok := kv.entries[k]
+	if !ok {
+		return entry.value, false
+	}
+
+	if kv.expired(entry, kv.now()) {
+		// The expired record is dropped from the log when it is next compacted
+		kv.forget(k)
+
+		var zero V
+
+		return zero, false
+	}
+
+	return entry.value, true
+}
+
+// Delete removes the value stored under the given key, if there is one.
+func (kv *FileKVStore[V]) Delete(k string) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if _, ok := kv.entries[k]; !ok {
+		return
+	}
+
+	if err := kv.append(opDelete, k, time.Time{}, nil); err != nil {
+		kv.logger.Error(fmt.Sprintf("failed to delete key %s: %v", k, err))
+		return
+	}
+
+	kv.forget(k)
+
+	kv.compactIfStale()
+}
+
+// Scan returns the entries whose keys start with prefix and whose values match, in
+// key order, up to limit of them. A nil match matches every value. The first page is
+// returned for an empty cursor, and each later page for the cursor returned with the
+// page before it. The cursor returned with the last page is empty. A limit of 0 or
+// less returns every entry at once.
+//
+// The first scan builds an index of the keys in order, which is kept up to date from
+// then on, so that later pages are found without sorting every key.
+func (kv *FileKVStore[V]) Scan(prefix, cursor string, limit int, match func(V) bool) ([]Entry[V], string, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if kv.index == nil {
+		kv.index = newKeyIndex(slices.Collect(maps.Keys(kv.entries)))
+	}
+
+	now := kv.now()
+
+	entries, next := scanIndex(kv.index, prefix, cursor, limit, func(key string) (V, bool) {
+		entry := kv.entries[key]
+
+		return entry.value, !kv.expired(entry, now) && (match == nil || match(entry.value))
+	})
+
+	return entries, next, nil
+}
+
+// Compact rewrites the log with only the values currently stored, dropping every
+// overwritten, deleted and expired record.
+func (kv *FileKVStore[V]) Compact() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	return kv.compact()
+}
+
+// Close closes the log file. The store must not be used once it is closed.
+func (kv *FileKVStore[V]) Close() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	return kv.file.Close()
+}
+
+func (kv *FileKVStore[V]) load() error {
+	info, err := kv.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(kv.file)
+	now := kv.now()
+
+	var offset int64
+
+	for {
+		payload, err := readRecord(reader, info.Size()-offset)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if errors.Is(err, errTornRecord) {
+			kv.logger.Warn(fmt.Sprintf("truncating torn record at offset %d of %s", offset, kv.path))
+
+			if err := kv.truncate(offset); err != nil {
+				return err
+			}
+
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("record at offset %d: %w", offset, err)
+		}
+
+		if err := kv.replay(payload, now); err != nil {
+			return fmt.Errorf("record at offset %d: %w", offset, err)
+		}
+
+		offset += int64(recordHeaderSize + len(payload))
+		kv.records++
+	}
+
+	kv.size = offset
+
+	return nil
+}
+
+func (kv *FileKVStore[V]) replay(payload []byte, now time.Time) error {
+	op, key, expiresAt, value, err := decodePayload(payload)
+	if err != nil {
+		return err
+	}
+
+	switch op {
+	case opPut:
+		v, err := kv.encoder.Deserialise(value)
+		if err != nil {
+			return err
+		}
+
+		entry := fileEntry[V]{value: v, expiresAt: expiresAt}
+		if kv.expired(entry, now) {
+			delete(kv.entries, key)
+			return nil
+		}
+
+		kv.entries[key] = entry
+
+	case opDelete:
+		delete(kv.entries, key)
+
+	default:
+		return fmt.Errorf("unknown op %d", op)
+	}
+
+	return nil
+}
+
+// append writes a record to the end of the log and syncs it to disk. If either
+// fails, the log is truncated back to its previous size so that a partly written
+// record is not followed by later ones.
+func (kv *FileKVStore[V]) append(op byte, key string, expiresAt time.Time, value []byte) error {
+	record := encodeRecord(op, key, expiresAt, value)
+
+	_, err := kv.file.WriteAt(record, kv.size)
+	if err == nil {
+		err = kv.file.Sync()
+	}
+
+	if err != nil {
+		if truncateErr := kv.truncate(kv.size); truncateErr != nil {
+			kv.logger.Error(fmt.Sprintf("failed to truncate %s: %v", kv.path, truncateErr))
+		}
+
+		return err
+	}
+
+	kv.size += int64(len(record))
+	kv.records++
+
+	return nil
+}
+
+func (kv *FileKVStore[V]) truncate(size int64) error {
+	if err := kv.file.Truncate(size); err != nil {
+		return err
+	}
+
+	return kv.file.Sync()
+}
+
+// compactIfStale compacts the log once its stale records pass the threshold and
+// outnumber the live ones, so that compaction at most doubles the cost of writing
+func (kv *FileKVStore[V]) compactIfStale() {
+	stale := kv.records - len(kv.entries)
+
+	if kv.compactAfter <= 0 || stale < kv.compactAfter || stale <= len(kv.entries) {
+		return
+	}
+
+	if err := kv.compact(); err != nil {
+		kv.logger.Error(fmt.Sprintf("failed to compact %s: %v", kv.path, err))
+	}
+}
+
+// compact writes the live values to a new log, then renames it over the old one,
+// so that a crash at any point leaves one whole log in place.
+func (kv *FileKVStore[V]) compact() error {
+	tmpPath := compactionPath(kv.path)
+
+	tmp, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	size, err := kv.writeEntries(tmp)
+	if err == nil {
+		err = tmp.Sync()
+	}
+
+	if err == nil {
+		err = os.Rename(tmpPath, kv.path)
+	}
+
+	if err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+
+		return err
+	}
+
+	// The rename is only durable once the directory is synced. The new log is
+	// already in place, so it is used even if that fails.
+	syncErr := syncDir(filepath.Dir(kv.path))
+
+	_ = kv.file.Close()
+	kv.file = tmp
+	kv.size = size
+	kv.records = len(kv.entries)
+
+	return syncErr
+}
+
+func (kv *FileKVStore[V]) writeEntries(w io.Writer) (int64, error) {
+	buffered := bufio.NewWriter(w)
+	now := kv.now()
+
+	var size int64
+
+	for key, entry := range kv.entries {
+		if kv.expired(entry, now) {
+			kv.forget(key)
+			continue
+		}
+
+		encoded, err := kv.encoder.Serialise(entry.value)
+		if err != nil {
+			return 0, err
+		}
+
+		record := encodeRecord(opPut, key, entry.expiresAt, encoded)
+		if _, err := buffered.Write(record); err != nil {
+			return 0, err
+		}
+
+		size += int64(len(record))
+	}
+
+	return size, buffered.Flush()
+}
+
+// forget removes the key from memory, leaving the log unchanged.
+func (kv *FileKVStore[V]) forget(k string) {
+	delete(kv.entries, k)
+
+	if kv.index != nil {
+		kv.index.remove(k)
+	}
+}
+
+func (kv *FileKVStore[V]) expired(entry fileEntry[V], now time.Time) bool {
+	return !entry.expiresAt.IsZero() && !now.Before(entry.expiresAt)
+}
+
+func compactionPath(path string) string {
+	return path + ".compact"
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(filepath.Clean(dir))
+	if err != nil {
+		return err
+	}
+
+	defer d.Close()
+
+	return d.Sync()
+}
+
+func encodeRecord(op byte, key string, expiresAt time.Time, value []byte) []byte {
+	payloadSize := payloadHeaderSize + len(key) + len(value)
+	record := make([]byte, recordHeaderSize+payloadSize)
+	payload := record[recordHeaderSize:]
+
+	var expiry int64
+	if !expiresAt.IsZero() {
+		expiry = expiresAt.UnixNano()
+	}
+
+	payload[0] = op
+	binary.BigEndian.PutUint64(payload[1:9], uint64(expiry))    // nolint:gosec // round-trips through decodePayload
+	binary.BigEndian.PutUint32(payload[9:13], uint32(len(key))) // nolint:gosec // keys are far smaller than 4GiB
+	copy(payload[payloadHeaderSize:], key)
+	copy(payload[payloadHeaderSize+len(key):], value)
+
+	binary.BigEndian.PutUint32(record[0:4], uint32(payloadSize)) // nolint:gosec // records are far smaller than 4GiB
+	binary.BigEndian.PutUint32(record[4:8], crc32.Checksum(payload, crcTable))
+
+	return record
+}
+
+// readRecord reads the payload of the next record, given how many bytes are left in
+// the log. It returns io.EOF at the end of the log, errTornRecord if the rest of the
+// log is one torn record, and errCorruptRecord if the record is bad but more of the
+// log follows it.
+func readRecord(r io.Reader, remaining int64) ([]byte, error) {
+	header := make([]byte, recordHeaderSize)
+
+	if _, err := io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, errTornRecord
+		}
+
+		return nil, err
+	}
+
+	size := int64(binary.BigEndian.Uint32(header[0:4]))
+	if size > remaining-recordHeaderSize {
+		return nil, errTornRecord
+	}
+
+	if size < payloadHeaderSize {
+		// A crash can leave the end of the log extended but never written
+		rest, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if allZero(header) && allZero(rest) {
+			return nil, errTornRecord
+		}
+
+		return nil, errCorruptRecord
+	}
+
+	payload := make([]byte, size)
+
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+			return nil, errTornRecord
+		}
+
+		return nil, err
+	}
+
+	if crc32.Checksum(payload, crcTable) != binary.BigEndian.Uint32(header[4:8]) {
+		if size == remaining-recordHeaderSize {
+			return nil, errTornRecord
+		}
+
+		return nil, errCorruptRecord
+	}
+
+	return payload, nil
+}
+
+func allZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func decodePayload(payload []byte) (byte, string, time.Time, []byte, error) {
+	keySize := int(binary.BigEndian.Uint32(payload[9:13]))
+	if keySize > len(payload)-payloadHeaderSize {
+		return 0, "", time.Time{}, nil, errors.New("key overruns record")
+	}
+
+	var expiresAt time.Time
+	if expiry := int64(binary.BigEndian.Uint64(payload[1:9])); expiry != 0 { // nolint:gosec // written by encodeRecord
+		expiresAt = time.Unix(0, expiry)
+	}
+
+	key := string(payload[payloadHeaderSize : payloadHeaderSize+keySize])
+	value := payload[payloadHeaderSize+keySize:]
+
+	return payload[0], key, expiresAt, value, nil
+}
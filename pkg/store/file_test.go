@@ -0,0 +1,394 @@
+//go:build unit
+
+package store
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/pkg/log"
+	"github.com/jamesTait-jt/goflow/pkg/serialise"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func openTestFileKVStore(t *testing.T, path string, opts ...PersistentOption) *FileKVStore[storedValue] {
+	t.Helper()
+
+	logger := new(log.TestifyMock)
+	logger.On("Warn", mock.Anything).Maybe()
+
+	opts = append([]PersistentOption{WithLogger(logger)}, opts...)
+
+	kv, err := OpenFileKVStore[storedValue](path, serialise.NewGobSerialiser[storedValue](), opts...)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	t.Cleanup(func() { _ = kv.Close() })
+
+	return kv
+}
+
+func fileSize(t *testing.T, path string) int64 {
+	t.Helper()
+
+	info, err := os.Stat(path)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return info.Size()
+}
+
+func Test_FileKVStore(t *testing.T) {
+	t.Run("Keeps puts and deletes across reopening", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "results.log")
+
+		kv := openTestFileKVStore(t, path)
+		kv.Put("kept", storedValue{Name: "first"})
+		kv.Put("kept", storedValue{Name: "second"})
+		kv.Put("deleted", storedValue{Name: "deleted"})
+		kv.Delete("deleted")
+		_ = kv.Close()
+
+		// Act
+		reopened := openTestFileKVStore(t, path)
+
+		// Assert
+		kept, keptOK := reopened.Get("kept")
+		_, deletedOK := reopened.Get("deleted")
+
+		assert.True(t, keptOK)
+		assert.Equal(t, storedValue{Name: "second"}, kept)
+		assert.False(t, deletedOK)
+		assert.Equal(t, 4, reopened.records)
+	})
+
+	t.Run("Expires values once their TTL has passed, including across reopening", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "results.log")
+
+		kv := openTestFileKVStore(t, path)
+
+		kv.now = func() time.Time { return time.Now().Add(-2 * time.Minute) }
+
+		kv.PutWithTTL("short", storedValue{Name: "short"}, time.Minute)
+		kv.PutWithTTL("long", storedValue{Name: "long"}, time.Hour)
+
+		kv.now = time.Now
+
+		// Act
+		_, shortOK := kv.Get("short")
+		_ = kv.Close()
+
+		reopened := openTestFileKVStore(t, path)
+		_, reopenedShortOK := reopened.Get("short")
+		_, reopenedLongOK := reopened.Get("long")
+
+		// Assert
+		assert.False(t, shortOK)
+		assert.False(t, reopenedShortOK)
+		assert.True(t, reopenedLongOK)
+	})
+
+	t.Run("Does not write a record when deleting a key that is not stored", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "results.log")
+		kv := openTestFileKVStore(t, path)
+
+		// Act
+		kv.Delete("missing")
+
+		// Assert
+		assert.Equal(t, int64(0), fileSize(t, path))
+	})
+
+	t.Run("Returns an error if a whole record cannot be decoded", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "results.log")
+
+		record := encodeRecord(opPut, "key", time.Time{}, []byte("not gob"))
+		_ = os.WriteFile(path, record, 0o600)
+
+		// Act
+		_, err := OpenFileKVStore[storedValue](path, serialise.NewGobSerialiser[storedValue]())
+
+		// Assert
+		assert.ErrorContains(t, err, "record at offset 0")
+	})
+}
+
+func Test_FileKVStore_Scan(t *testing.T) {
+	t.Run("Pages through the entries with the prefix in key order", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "results.log")
+
+		kv := openTestFileKVStore(t, path)
+		kv.Put("b:2", storedValue{Name: "2"})
+		kv.Put("a:1", storedValue{Name: "1"})
+		kv.Put("b:1", storedValue{Name: "1"})
+		kv.Put("b:3", storedValue{Name: "3"})
+		kv.Delete("b:3")
+
+		// Act
+		first, cursor, firstErr := kv.Scan("b:", "", 1, nil)
+		second, last, secondErr := kv.Scan("b:", cursor, 1, nil)
+
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.Equal(t, []Entry[storedValue]{{Key: "b:1", Value: storedValue{Name: "1"}}}, first)
+		assert.Equal(t, "b:1", cursor)
+
+		assert.NoError(t, secondErr)
+		assert.Equal(t, []Entry[storedValue]{{Key: "b:2", Value: storedValue{Name: "2"}}}, second)
+		assert.Equal(t, "", last)
+	})
+
+	t.Run("Only returns matching entries and keeps its index up to date", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "results.log")
+
+		kv := openTestFileKVStore(t, path)
+		kv.Put("a", storedValue{Name: "keep"})
+		kv.Put("b", storedValue{Name: "skip"})
+		_, _, _ = kv.Scan("", "", 0, nil)
+
+		kept := func(v storedValue) bool { return v.Name == "keep" }
+
+		// Act
+		kv.Put("c", storedValue{Name: "keep"})
+		kv.Put("d", storedValue{Name: "keep"})
+		kv.Delete("a")
+
+		first, cursor, firstErr := kv.Scan("", "", 1, kept)
+		second, last, secondErr := kv.Scan("", cursor, 1, kept)
+
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.Equal(t, []Entry[storedValue]{{Key: "c", Value: storedValue{Name: "keep"}}}, first)
+		assert.Equal(t, "c", cursor)
+
+		assert.NoError(t, secondErr)
+		assert.Equal(t, []Entry[storedValue]{{Key: "d", Value: storedValue{Name: "keep"}}}, second)
+		assert.Equal(t, "", last)
+		assert.Equal(t, []string{"b", "c", "d"}, kv.index.keys)
+	})
+}
+
+func Test_FileKVStore_TornWrites(t *testing.T) {
+	tests := []struct {
+		name string
+		tear func(written []byte, lastRecordAt int) []byte
+	}{
+		{
+			name: "Last record cut short",
+			tear: func(written []byte, _ int) []byte {
+				return written[:len(written)-3]
+			},
+		},
+		{
+			name: "Only part of the last record's header written",
+			tear: func(written []byte, lastRecordAt int) []byte {
+				return written[:lastRecordAt+recordHeaderSize/2]
+			},
+		},
+		{
+			name: "Last record's payload corrupted",
+			tear: func(written []byte, _ int) []byte {
+				torn := append([]byte{}, written...)
+				torn[len(torn)-1] ^= 0xff
+
+				return torn
+			},
+		},
+		{
+			name: "Last record's length corrupted beyond the end of the log",
+			tear: func(written []byte, lastRecordAt int) []byte {
+				torn := append([]byte{}, written...)
+				binary.BigEndian.PutUint32(torn[lastRecordAt:], 1<<30)
+
+				return torn
+			},
+		},
+		{
+			name: "Last record left as zeros",
+			tear: func(written []byte, lastRecordAt int) []byte {
+				torn := append([]byte{}, written...)
+				clear(torn[lastRecordAt:])
+
+				return torn
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			path := filepath.Join(t.TempDir(), "results.log")
+
+			kv := openTestFileKVStore(t, path)
+			kv.Put("first", storedValue{Name: "first"})
+			kv.Put("second", storedValue{Name: "second"})
+
+			lastRecordAt := int(kv.size)
+
+			kv.Put("torn", storedValue{Name: "torn"})
+			_ = kv.Close()
+
+			written, _ := os.ReadFile(path)
+			_ = os.WriteFile(path, tt.tear(written, lastRecordAt), 0o600)
+
+			// Act
+			reopened := openTestFileKVStore(t, path)
+			reopened.Put("after", storedValue{Name: "after"})
+			_ = reopened.Close()
+
+			recovered := openTestFileKVStore(t, path)
+
+			// Assert
+			first, firstOK := recovered.Get("first")
+			second, secondOK := recovered.Get("second")
+			_, tornOK := recovered.Get("torn")
+			after, afterOK := recovered.Get("after")
+
+			assert.True(t, firstOK)
+			assert.Equal(t, storedValue{Name: "first"}, first)
+			assert.True(t, secondOK)
+			assert.Equal(t, storedValue{Name: "second"}, second)
+			assert.False(t, tornOK)
+			assert.True(t, afterOK)
+			assert.Equal(t, storedValue{Name: "after"}, after)
+			assert.Equal(t, recovered.size, fileSize(t, path))
+		})
+	}
+
+	corruptions := []struct {
+		name    string
+		corrupt func(written []byte, recordAt int)
+	}{
+		{
+			name: "Payload corrupted",
+			corrupt: func(written []byte, recordAt int) {
+				written[recordAt+recordHeaderSize] ^= 0xff
+			},
+		},
+		{
+			name: "Length corrupted to less than a payload header",
+			corrupt: func(written []byte, recordAt int) {
+				binary.BigEndian.PutUint32(written[recordAt:], 1)
+			},
+		},
+	}
+
+	for _, tt := range corruptions {
+		t.Run("Returns an error if a record before the end of the log is corrupt: "+tt.name, func(t *testing.T) {
+			// Arrange
+			path := filepath.Join(t.TempDir(), "results.log")
+
+			kv := openTestFileKVStore(t, path)
+			kv.Put("first", storedValue{Name: "first"})
+
+			recordAt := int(kv.size)
+
+			kv.Put("second", storedValue{Name: "second"})
+			kv.Put("third", storedValue{Name: "third"})
+			_ = kv.Close()
+
+			written, _ := os.ReadFile(path)
+			tt.corrupt(written, recordAt)
+			_ = os.WriteFile(path, written, 0o600)
+
+			// Act
+			_, err := OpenFileKVStore[storedValue](path, serialise.NewGobSerialiser[storedValue]())
+
+			// Assert
+			assert.ErrorIs(t, err, errCorruptRecord)
+			assert.Equal(t, int64(len(written)), fileSize(t, path))
+		})
+	}
+}
+
+func Test_FileKVStore_Compact(t *testing.T) {
+	t.Run("Drops overwritten, deleted and expired records", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "results.log")
+
+		kv := openTestFileKVStore(t, path, WithCompactAfter(0))
+
+		now := time.Now()
+		kv.now = func() time.Time { return now }
+
+		for i := 0; i < 10; i++ {
+			kv.Put("overwritten", storedValue{Name: "value"})
+		}
+
+		kv.Put("deleted", storedValue{Name: "deleted"})
+		kv.Delete("deleted")
+		kv.PutWithTTL("expired", storedValue{Name: "expired"}, time.Minute)
+
+		now = now.Add(time.Hour)
+
+		// Act
+		err := kv.Compact()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, kv.records)
+		assert.Equal(t, kv.size, fileSize(t, path))
+		assert.NoFileExists(t, compactionPath(path))
+
+		kv.Put("after", storedValue{Name: "after"})
+		_ = kv.Close()
+
+		reopened := openTestFileKVStore(t, path)
+		overwritten, overwrittenOK := reopened.Get("overwritten")
+		_, afterOK := reopened.Get("after")
+
+		assert.True(t, overwrittenOK)
+		assert.Equal(t, storedValue{Name: "value"}, overwritten)
+		assert.True(t, afterOK)
+		assert.Equal(t, 2, reopened.records)
+	})
+
+	t.Run("Compacts automatically once stale records pass the threshold", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "results.log")
+
+		kv := openTestFileKVStore(t, path, WithCompactAfter(5))
+
+		// Act
+		for i := 0; i < 6; i++ {
+			kv.Put("key", storedValue{Name: "value"})
+		}
+
+		// Assert
+		assert.Equal(t, 1, kv.records)
+		assert.Equal(t, kv.size, fileSize(t, path))
+	})
+
+	t.Run("Ignores a compaction interrupted by a crash", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "results.log")
+
+		kv := openTestFileKVStore(t, path)
+		kv.Put("key", storedValue{Name: "value"})
+		_ = kv.Close()
+
+		_ = os.WriteFile(compactionPath(path), []byte("unfinished"), 0o600)
+
+		// Act
+		reopened := openTestFileKVStore(t, path)
+
+		// Assert
+		value, ok := reopened.Get("key")
+
+		assert.True(t, ok)
+		assert.Equal(t, storedValue{Name: "value"}, value)
+		assert.NoFileExists(t, compactionPath(path))
+	})
+}
@@ -1,23 +1,92 @@
 package store
 
-import "sync"
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+)
 
+// InMemoryKVStore is a key-value store for a single process. Entries put with a TTL
+// expire once it has passed, and the store can be capped to a maximum number of
+// entries, evicting the least recently used (see Option).
 type InMemoryKVStore[K comparable, V any] struct {
-	data map[K]V
-	mu   sync.Mutex
+	data       map[K]V
+	expiries   map[K]time.Time
+	recency    *list.List
+	elements   map[K]*list.Element
+	maxEntries int
+	mu         sync.Mutex
+	now        func() time.Time
+	stop       chan struct{}
+	closeOnce  sync.Once
+	sweeperWG  sync.WaitGroup
+
+	// Built by the first Scan. keysByString maps the string form of each key in
+	// the index back to the key.
+	index        *keyIndex
+	keysByString map[string]K
 }
 
-func NewInMemoryKVStore[K comparable, V any]() *InMemoryKVStore[K, V] {
-	return &InMemoryKVStore[K, V]{
-		data: make(map[K]V),
+func NewInMemoryKVStore[K comparable, V any](opts ...Option) *InMemoryKVStore[K, V] {
+	options := options{}
+
+	for _, o := range opts {
+		o.apply(&options)
+	}
+
+	kv := &InMemoryKVStore[K, V]{
+		data:       make(map[K]V),
+		expiries:   make(map[K]time.Time),
+		recency:    list.New(),
+		elements:   make(map[K]*list.Element),
+		maxEntries: options.maxEntries,
+		now:        time.Now,
+		stop:       make(chan struct{}),
+	}
+
+	if options.sweepInterval > 0 {
+		kv.sweeperWG.Add(1)
+
+		go kv.sweep(options.sweepInterval)
 	}
+
+	return kv
 }
 
+// Put stores the value under the given key with no expiry.
 func (kv *InMemoryKVStore[K, V]) Put(k K, v V) {
+	kv.PutWithTTL(k, v, 0)
+}
+
+// PutWithTTL stores the value under the given key until the TTL has passed. A TTL
+// of 0 or less means the value never expires.
+func (kv *InMemoryKVStore[K, V]) PutWithTTL(k K, v V, ttl time.Duration) {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
 
+	if _, exists := kv.data[k]; !exists && kv.maxEntries > 0 {
+		for len(kv.data) >= kv.maxEntries {
+			if !kv.evictLeastRecentlyUsed() {
+				break
+			}
+		}
+	}
+
+	if _, exists := kv.data[k]; !exists && kv.index != nil {
+		key := fmt.Sprint(k)
+		kv.index.add(key)
+		kv.keysByString[key] = k
+	}
+
 	kv.data[k] = v
+	kv.touch(k)
+
+	if ttl > 0 {
+		kv.expiries[k] = kv.now().Add(ttl)
+	} else {
+		delete(kv.expiries, k)
+	}
 }
 
 func (kv *InMemoryKVStore[K, V]) Get(k K) (V, bool) {
@@ -25,6 +94,156 @@ func (kv *InMemoryKVStore[K, V]) Get(k K) (V, bool) {
 	defer kv.mu.Unlock()
 
 	v, ok := kv.data[k]
+	if !ok {
+		return v, false
+	}
+
+	if kv.expired(k, kv.now()) {
+		kv.remove(k)
+
+		var zero V
+
+		return zero, false
+	}
+
+	kv.touch(k)
 
 	return v, ok
 }
+
+// Delete removes the value stored under the given key, if there is one.
+func (kv *InMemoryKVStore[K, V]) Delete(k K) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	kv.remove(k)
+}
+
+// Scan returns the entries whose keys start with prefix and whose values match, in
+// key order, up to limit of them. Keys are compared by their string form, and a nil
+// match matches every value. The first page is returned for an empty cursor, and each
+// later page for the cursor returned with the page before it. The cursor returned
+// with the last page is empty. A limit of 0 or less returns every entry at once.
+// Scanning does not count as using an entry for eviction.
+//
+// The first scan builds an index of the keys in order, which is kept up to date from
+// then on, so that later pages are found without sorting every key.
+func (kv *InMemoryKVStore[K, V]) Scan(prefix, cursor string, limit int, match func(V) bool) ([]Entry[V], string, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if kv.index == nil {
+		kv.keysByString = make(map[string]K, len(kv.data))
+		keys := make([]string, 0, len(kv.data))
+
+		for k := range kv.data {
+			key := fmt.Sprint(k)
+			kv.keysByString[key] = k
+			keys = append(keys, key)
+		}
+
+		kv.index = newKeyIndex(keys)
+	}
+
+	now := kv.now()
+
+	entries, next := scanIndex(kv.index, prefix, cursor, limit, func(key string) (V, bool) {
+		k := kv.keysByString[key]
+		v := kv.data[k]
+
+		return v, !kv.expired(k, now) && (match == nil || match(v))
+	})
+
+	return entries, next, nil
+}
+
+// Sweep removes every expired entry, returning how many were removed.
+func (kv *InMemoryKVStore[K, V]) Sweep() int {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	now := kv.now()
+	swept := 0
+
+	for k := range kv.expiries {
+		if kv.expired(k, now) {
+			kv.remove(k)
+			swept++
+		}
+	}
+
+	return swept
+}
+
+// Close stops the background sweeper, if one was started. It is safe to call more
+// than once.
+func (kv *InMemoryKVStore[K, V]) Close() error {
+	kv.closeOnce.Do(func() {
+		close(kv.stop)
+	})
+
+	kv.sweeperWG.Wait()
+
+	return nil
+}
+
+func (kv *InMemoryKVStore[K, V]) sweep(interval time.Duration) {
+	defer kv.sweeperWG.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-kv.stop:
+			return
+
+		case <-ticker.C:
+			kv.Sweep()
+		}
+	}
+}
+
+func (kv *InMemoryKVStore[K, V]) expired(k K, now time.Time) bool {
+	expiresAt, ok := kv.expiries[k]
+
+	return ok && !now.Before(expiresAt)
+}
+
+// touch marks k as the most recently used key
+func (kv *InMemoryKVStore[K, V]) touch(k K) {
+	if element, ok := kv.elements[k]; ok {
+		kv.recency.MoveToFront(element)
+		return
+	}
+
+	kv.elements[k] = kv.recency.PushFront(k)
+}
+
+func (kv *InMemoryKVStore[K, V]) evictLeastRecentlyUsed() bool {
+	oldest := kv.recency.Back()
+	if oldest == nil {
+		return false
+	}
+
+	k, _ := oldest.Value.(K)
+	kv.remove(k)
+
+	return true
+}
+
+func (kv *InMemoryKVStore[K, V]) remove(k K) {
+	if _, exists := kv.data[k]; exists && kv.index != nil {
+		key := fmt.Sprint(k)
+		kv.index.remove(key)
+		delete(kv.keysByString, key)
+	}
+
+	delete(kv.data, k)
+	delete(kv.expiries, k)
+
+	if element, ok := kv.elements[k]; ok {
+		kv.recency.Remove(element)
+		delete(kv.elements, k)
+	}
+}
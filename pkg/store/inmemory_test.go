@@ -4,6 +4,7 @@ package store
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -72,3 +73,254 @@ func Test_InMemoryKVStore_Get(t *testing.T) {
 		assert.Equal(t, 0, retrieved)
 	})
 }
+
+func Test_InMemoryKVStore_PutWithTTL(t *testing.T) {
+	t.Run("Expires the element once the TTL has passed", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryKVStore[string, int]()
+
+		now := time.Now()
+		s.now = func() time.Time { return now }
+
+		s.PutWithTTL("foo", 10, time.Minute)
+
+		// Act
+		_, beforeExpiry := s.Get("foo")
+
+		now = now.Add(time.Minute)
+		_, afterExpiry := s.Get("foo")
+
+		// Assert
+		assert.True(t, beforeExpiry)
+		assert.False(t, afterExpiry)
+		assert.Empty(t, s.data)
+	})
+
+	t.Run("Never expires the element if the TTL is not positive", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryKVStore[string, int]()
+
+		now := time.Now()
+		s.now = func() time.Time { return now }
+
+		s.PutWithTTL("foo", 10, 0)
+		now = now.Add(24 * time.Hour)
+
+		// Act
+		retrieved, ok := s.Get("foo")
+
+		// Assert
+		assert.True(t, ok)
+		assert.Equal(t, 10, retrieved)
+	})
+
+	t.Run("Clears the TTL when the element is put again without one", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryKVStore[string, int]()
+
+		now := time.Now()
+		s.now = func() time.Time { return now }
+
+		s.PutWithTTL("foo", 10, time.Minute)
+		s.Put("foo", 11)
+		now = now.Add(time.Hour)
+
+		// Act
+		retrieved, ok := s.Get("foo")
+
+		// Assert
+		assert.True(t, ok)
+		assert.Equal(t, 11, retrieved)
+	})
+}
+
+func Test_InMemoryKVStore_Delete(t *testing.T) {
+	t.Run("Removes the element from the store", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryKVStore[string, int]()
+		s.PutWithTTL("foo", 10, time.Minute)
+
+		// Act
+		s.Delete("foo")
+
+		// Assert
+		_, ok := s.Get("foo")
+
+		assert.False(t, ok)
+		assert.Empty(t, s.expiries)
+		assert.Empty(t, s.elements)
+		assert.Equal(t, 0, s.recency.Len())
+	})
+}
+
+func Test_InMemoryKVStore_MaxEntries(t *testing.T) {
+	t.Run("Evicts the least recently used element once the cap is reached", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryKVStore[string, int](WithMaxEntries(2))
+
+		s.Put("first", 1)
+		s.Put("second", 2)
+		_, _ = s.Get("first")
+
+		// Act
+		s.Put("third", 3)
+
+		// Assert
+		_, firstOK := s.Get("first")
+		_, secondOK := s.Get("second")
+		_, thirdOK := s.Get("third")
+
+		assert.True(t, firstOK)
+		assert.False(t, secondOK)
+		assert.True(t, thirdOK)
+		assert.Len(t, s.data, 2)
+	})
+
+	t.Run("Does not evict when an existing element is overwritten", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryKVStore[string, int](WithMaxEntries(2))
+
+		s.Put("first", 1)
+		s.Put("second", 2)
+
+		// Act
+		s.Put("first", 10)
+
+		// Assert
+		assert.Equal(t, map[string]int{"first": 10, "second": 2}, s.data)
+	})
+}
+
+func Test_InMemoryKVStore_Sweep(t *testing.T) {
+	t.Run("Removes only the expired elements", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryKVStore[string, int]()
+
+		now := time.Now()
+		s.now = func() time.Time { return now }
+
+		s.PutWithTTL("short", 1, time.Second)
+		s.PutWithTTL("long", 2, time.Hour)
+		s.Put("forever", 3)
+
+		now = now.Add(time.Minute)
+
+		// Act
+		swept := s.Sweep()
+
+		// Assert
+		assert.Equal(t, 1, swept)
+		assert.Equal(t, map[string]int{"long": 2, "forever": 3}, s.data)
+	})
+
+	t.Run("Sweeps in the background until closed", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryKVStore[string, int](WithSweepInterval(time.Millisecond))
+
+		// Act
+		s.PutWithTTL("foo", 10, time.Millisecond)
+
+		// Assert
+		assert.Eventually(t, func() bool {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			return len(s.data) == 0
+		}, time.Second, time.Millisecond)
+
+		assert.NoError(t, s.Close())
+		assert.NoError(t, s.Close())
+	})
+}
+
+func Test_InMemoryKVStore_Scan(t *testing.T) {
+	t.Run("Pages through the entries with the prefix in key order", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryKVStore[string, int]()
+
+		s.Put("b:2", 2)
+		s.Put("a:1", 1)
+		s.Put("b:1", 1)
+		s.Put("b:3", 3)
+
+		// Act
+		first, cursor, firstErr := s.Scan("b:", "", 2, nil)
+		second, last, secondErr := s.Scan("b:", cursor, 2, nil)
+
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.Equal(t, []Entry[int]{{Key: "b:1", Value: 1}, {Key: "b:2", Value: 2}}, first)
+		assert.Equal(t, "b:2", cursor)
+
+		assert.NoError(t, secondErr)
+		assert.Equal(t, []Entry[int]{{Key: "b:3", Value: 3}}, second)
+		assert.Equal(t, "", last)
+	})
+
+	t.Run("Returns every entry if there is no limit, skipping expired ones", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryKVStore[string, int]()
+
+		now := time.Now()
+		s.now = func() time.Time { return now }
+
+		s.Put("kept", 1)
+		s.PutWithTTL("expired", 2, time.Second)
+
+		now = now.Add(time.Minute)
+
+		// Act
+		entries, cursor, err := s.Scan("", "", 0, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []Entry[int]{{Key: "kept", Value: 1}}, entries)
+		assert.Equal(t, "", cursor)
+	})
+
+	t.Run("Only counts matching entries towards the limit", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryKVStore[string, int]()
+
+		for i, key := range []string{"a", "b", "c", "d", "e"} {
+			s.Put(key, i)
+		}
+
+		even := func(v int) bool { return v%2 == 0 }
+
+		// Act
+		first, cursor, firstErr := s.Scan("", "", 2, even)
+		second, last, secondErr := s.Scan("", cursor, 2, even)
+
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.Equal(t, []Entry[int]{{Key: "a", Value: 0}, {Key: "c", Value: 2}}, first)
+		assert.Equal(t, "c", cursor)
+
+		assert.NoError(t, secondErr)
+		assert.Equal(t, []Entry[int]{{Key: "e", Value: 4}}, second)
+		assert.Equal(t, "", last)
+	})
+
+	t.Run("Keeps its index of keys up to date after the first scan", func(t *testing.T) {
+		// Arrange
+		s := NewInMemoryKVStore[string, int](WithMaxEntries(3))
+
+		s.Put("b", 2)
+		s.Put("d", 4)
+		_, _, _ = s.Scan("", "", 0, nil)
+
+		// Act
+		s.Put("a", 1)
+		s.Put("c", 3)
+		s.Delete("d")
+		s.Put("b", 5)
+
+		entries, _, err := s.Scan("", "", 0, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []Entry[int]{{Key: "a", Value: 1}, {Key: "b", Value: 5}, {Key: "c", Value: 3}}, entries)
+		assert.Equal(t, []string{"a", "b", "c"}, s.index.keys)
+	})
+}
@@ -0,0 +1,97 @@
+package store
+
+import (
+	"time"
+
+	"github.com/jamesTait-jt/goflow/pkg/log"
+)
+
+type options struct {
+	maxEntries    int
+	sweepInterval time.Duration
+}
+
+// An Option sets options such as the maximum number of entries kept.
+type Option interface {
+	apply(*options)
+}
+
+type maxEntriesOption struct {
+	MaxEntries int
+}
+
+func (m maxEntriesOption) apply(opts *options) {
+	opts.maxEntries = m.MaxEntries
+}
+
+// WithMaxEntries caps the number of entries in the store. Once the cap is reached,
+// each new key evicts the least recently used entry. A cap of 0 or less leaves the
+// store unbounded, which is the default.
+func WithMaxEntries(maxEntries int) Option {
+	return maxEntriesOption{MaxEntries: maxEntries}
+}
+
+type sweepIntervalOption struct {
+	SweepInterval time.Duration
+}
+
+func (s sweepIntervalOption) apply(opts *options) {
+	opts.sweepInterval = s.SweepInterval
+}
+
+// WithSweepInterval starts a background goroutine that removes expired entries at
+// the given interval, until the store is closed. Without it, expired entries are
+// only removed when they are next read or evicted.
+func WithSweepInterval(interval time.Duration) Option {
+	return sweepIntervalOption{SweepInterval: interval}
+}
+
+var defaultCompactAfter = 1000
+
+type persistentOptions struct {
+	logger       log.Logger
+	compactAfter int
+}
+
+func defaultPersistentOptions() persistentOptions {
+	return persistentOptions{
+		logger:       log.NewConsoleLogger(),
+		compactAfter: defaultCompactAfter,
+	}
+}
+
+// A PersistentOption sets options, such as logger, for the stores that keep their
+// values outside the process: RedisKVStore and FileKVStore.
+type PersistentOption interface {
+	apply(*persistentOptions)
+}
+
+type loggerOption struct {
+	Logger log.Logger
+}
+
+func (l loggerOption) apply(opts *persistentOptions) {
+	opts.logger = l.Logger
+}
+
+// WithLogger allows you to set the logger that reports failures to reach Redis or
+// the file, or to encode values.
+func WithLogger(logger log.Logger) PersistentOption {
+	return loggerOption{Logger: logger}
+}
+
+type compactAfterOption struct {
+	CompactAfter int
+}
+
+func (c compactAfterOption) apply(opts *persistentOptions) {
+	opts.compactAfter = c.CompactAfter
+}
+
+// WithCompactAfter allows you to set how many stale records a FileKVStore's log may
+// hold before it is compacted. Records are stale once their key is overwritten,
+// deleted or expired, and the log is only compacted once they also outnumber the
+// live records. A value of 0 or less disables automatic compaction. Defaults to 1000.
+func WithCompactAfter(staleRecords int) PersistentOption {
+	return compactAfterOption{CompactAfter: staleRecords}
+}
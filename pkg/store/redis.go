@@ -0,0 +1,224 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/pkg/log"
+	"github.com/redis/go-redis/v9"
+)
+
+type redisClient interface {
+	Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+	Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd
+	MGet(ctx context.Context, keys ...string) *redis.SliceCmd
+}
+
+var defaultScanCount int64 = 100
+
+// Encoder defines methods for serialising and deserialising the values kept in a
+// RedisKVStore. The encoders used by broker.RedisBroker satisfy it.
+type Encoder[V any] interface {
+	Serialise(toSerialise V) ([]byte, error)
+	Deserialise(toDeserialise []byte) (V, error)
+}
+
+// RedisKVStore is a key-value store shared by every process connected to the same
+// Redis, so that any server replica can read a value put by another. Each value is
+// stored encoded under its own Redis key, which expires with the value's TTL.
+//
+// The KVStore interface has no way of returning errors, so failures to reach Redis
+// or to encode a value are logged, and Get reports the key as not found.
+type RedisKVStore[V any] struct {
+	client  redisClient
+	prefix  string
+	encoder Encoder[V]
+	logger  log.Logger
+}
+
+// NewRedisKVStore creates a RedisKVStore that keeps each value under
+// prefix + ":" + key.
+func NewRedisKVStore[V any](client redisClient, prefix string, encoder Encoder[V], opts ...PersistentOption) *RedisKVStore[V] {
+	options := defaultPersistentOptions()
+
+	for _, o := range opts {
+		o.apply(&options)
+	}
+
+	return &RedisKVStore[V]{
+		client:  client,
+		prefix:  prefix,
+		encoder: encoder,
+		logger:  options.logger,
+	}
+}
+
+// Put stores the value under the given key with no expiry.
+func (r *RedisKVStore[V]) Put(k string, v V) {
+	r.PutWithTTL(k, v, 0)
+}
+
+// PutWithTTL stores the value under the given key until the TTL has passed. A TTL
+// of 0 or less means the value never expires.
+func (r *RedisKVStore[V]) PutWithTTL(k string, v V, ttl time.Duration) {
+	encoded, err := r.encoder.Serialise(v)
+	if err != nil {
+		r.logger.Error(fmt.Sprintf("failed to encode value for key %s: %v", k, err))
+		return
+	}
+
+	if ttl < 0 {
+		ttl = 0
+	}
+
+	if err := r.client.Set(context.Background(), r.redisKey(k), encoded, ttl).Err(); err != nil {
+		r.logger.Error(fmt.Sprintf("failed to put key %s: %v", k, err))
+	}
+}
+
+func (r *RedisKVStore[V]) Get(k string) (V, bool) {
+	var zero V
+
+	encoded, err := r.client.Get(context.Background(), r.redisKey(k)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return zero, false
+	}
+
+	if err != nil {
+		r.logger.Error(fmt.Sprintf("failed to get key %s: %v", k, err))
+		return zero, false
+	}
+
+	v, err := r.encoder.Deserialise(encoded)
+	if err != nil {
+		r.logger.Error(fmt.Sprintf("failed to decode value for key %s: %v", k, err))
+		return zero, false
+	}
+
+	return v, true
+}
+
+// Delete removes the value stored under the given key, if there is one.
+func (r *RedisKVStore[V]) Delete(k string) {
+	if err := r.client.Del(context.Background(), r.redisKey(k)).Err(); err != nil {
+		r.logger.Error(fmt.Sprintf("failed to delete key %s: %v", k, err))
+	}
+}
+
+// Scan returns a page of the entries whose keys start with prefix and whose values
+// match, using Redis SCAN. A nil match matches every value. The first page is
+// returned for an empty cursor, and each later page for the cursor returned with the
+// page before it. The cursor returned with the last page is empty.
+//
+// SCAN is repeated until at least limit entries match, so a page may hold more than
+// limit entries, but only the last may hold fewer. As with SCAN, entries are in no
+// particular order, and an entry may be returned more than once. A limit of 0 or less
+// returns every entry at once.
+func (r *RedisKVStore[V]) Scan(prefix, cursor string, limit int, match func(V) bool) ([]Entry[V], string, error) {
+	ctx := context.Background()
+
+	var redisCursor uint64
+
+	if cursor != "" {
+		parsed, err := strconv.ParseUint(cursor, 10, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid cursor %q: %w", cursor, err)
+		}
+
+		redisCursor = parsed
+	}
+
+	count := defaultScanCount
+	if limit > 0 {
+		count = int64(limit)
+	}
+
+	pattern := escapeGlob(r.redisKey(prefix)) + "*"
+	entries := []Entry[V]{}
+
+	for {
+		keys, next, err := r.client.Scan(ctx, redisCursor, pattern, count).Result()
+		if err != nil {
+			return nil, "", err
+		}
+
+		found, err := r.getAll(ctx, keys)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, entry := range found {
+			if match == nil || match(entry.Value) {
+				entries = append(entries, entry)
+			}
+		}
+
+		redisCursor = next
+
+		if redisCursor == 0 || (limit > 0 && len(entries) >= limit) {
+			break
+		}
+	}
+
+	if redisCursor == 0 {
+		return entries, "", nil
+	}
+
+	return entries, strconv.FormatUint(redisCursor, 10), nil
+}
+
+// getAll decodes the values stored under the given Redis keys, skipping any that
+// were deleted or expired since they were scanned
+func (r *RedisKVStore[V]) getAll(ctx context.Context, keys []string) ([]Entry[V], error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry[V], 0, len(values))
+
+	for i, value := range values {
+		encoded, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		v, err := r.encoder.Deserialise([]byte(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode value for key %s: %w", keys[i], err)
+		}
+
+		entries = append(entries, Entry[V]{Key: strings.TrimPrefix(keys[i], r.prefix+":"), Value: v})
+	}
+
+	return entries, nil
+}
+
+func (r *RedisKVStore[V]) redisKey(key string) string {
+	return r.prefix + ":" + key
+}
+
+// escapeGlob escapes the characters that SCAN's MATCH pattern treats specially.
+func escapeGlob(s string) string {
+	var escaped strings.Builder
+
+	for _, c := range s {
+		if strings.ContainsRune(`*?[]\`, c) {
+			escaped.WriteRune('\\')
+		}
+
+		escaped.WriteRune(c)
+	}
+
+	return escaped.String()
+}
@@ -0,0 +1,294 @@
+//go:build unit
+
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/pkg/log"
+	"github.com/jamesTait-jt/goflow/pkg/serialise"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type storedValue struct {
+	Name string
+}
+
+func Test_RedisKVStore_PutWithTTL(t *testing.T) {
+	t.Run("Sets the encoded value with the TTL as its expiry", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		encoder := serialise.NewGobSerialiser[storedValue]()
+		s := NewRedisKVStore[storedValue](client, "results", encoder)
+
+		value := storedValue{Name: "foo"}
+		encoded, _ := encoder.Serialise(value)
+
+		cmd := redis.NewStatusCmd(context.Background())
+		cmd.SetVal("OK")
+		client.On("Set", mock.Anything, "results:key", encoded, time.Minute).Once().Return(cmd)
+
+		// Act
+		s.PutWithTTL("key", value, time.Minute)
+
+		// Assert
+		client.AssertExpectations(t)
+	})
+
+	t.Run("Sets the value with no expiry if the TTL is not positive", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		encoder := serialise.NewGobSerialiser[storedValue]()
+		s := NewRedisKVStore[storedValue](client, "results", encoder)
+
+		value := storedValue{Name: "foo"}
+		encoded, _ := encoder.Serialise(value)
+
+		cmd := redis.NewStatusCmd(context.Background())
+		cmd.SetVal("OK")
+		client.On("Set", mock.Anything, "results:key", encoded, time.Duration(0)).Twice().Return(cmd)
+
+		// Act
+		s.Put("key", value)
+		s.PutWithTTL("key", value, -time.Minute)
+
+		// Assert
+		client.AssertExpectations(t)
+	})
+
+	t.Run("Logs an error if the value cannot be set", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		logger := new(log.TestifyMock)
+		s := NewRedisKVStore[storedValue](client, "results", serialise.NewGobSerialiser[storedValue](), WithLogger(logger))
+
+		cmd := redis.NewStatusCmd(context.Background())
+		cmd.SetErr(errors.New("connection refused"))
+		client.On("Set", mock.Anything, "results:key", mock.Anything, time.Duration(0)).Once().Return(cmd)
+
+		logger.On("Error", "failed to put key key: connection refused").Once()
+
+		// Act
+		s.Put("key", storedValue{})
+
+		// Assert
+		logger.AssertExpectations(t)
+	})
+}
+
+func Test_RedisKVStore_Get(t *testing.T) {
+	t.Run("Decodes the stored value", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		encoder := serialise.NewGobSerialiser[storedValue]()
+		s := NewRedisKVStore[storedValue](client, "results", encoder)
+
+		value := storedValue{Name: "foo"}
+		encoded, _ := encoder.Serialise(value)
+
+		cmd := redis.NewStringCmd(context.Background())
+		cmd.SetVal(string(encoded))
+		client.On("Get", mock.Anything, "results:key").Once().Return(cmd)
+
+		// Act
+		retrieved, ok := s.Get("key")
+
+		// Assert
+		assert.True(t, ok)
+		assert.Equal(t, value, retrieved)
+	})
+
+	t.Run("Returns false if the key does not exist", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		s := NewRedisKVStore[storedValue](client, "results", serialise.NewGobSerialiser[storedValue]())
+
+		cmd := redis.NewStringCmd(context.Background())
+		cmd.SetErr(redis.Nil)
+		client.On("Get", mock.Anything, "results:key").Once().Return(cmd)
+
+		// Act
+		retrieved, ok := s.Get("key")
+
+		// Assert
+		assert.False(t, ok)
+		assert.Equal(t, storedValue{}, retrieved)
+	})
+
+	t.Run("Logs an error and returns false if the value cannot be read", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		logger := new(log.TestifyMock)
+		s := NewRedisKVStore[storedValue](client, "results", serialise.NewGobSerialiser[storedValue](), WithLogger(logger))
+
+		cmd := redis.NewStringCmd(context.Background())
+		cmd.SetErr(errors.New("connection refused"))
+		client.On("Get", mock.Anything, "results:key").Once().Return(cmd)
+
+		logger.On("Error", "failed to get key key: connection refused").Once()
+
+		// Act
+		_, ok := s.Get("key")
+
+		// Assert
+		assert.False(t, ok)
+		logger.AssertExpectations(t)
+	})
+}
+
+func Test_RedisKVStore_Delete(t *testing.T) {
+	t.Run("Deletes the key", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		s := NewRedisKVStore[storedValue](client, "results", serialise.NewGobSerialiser[storedValue]())
+
+		cmd := redis.NewIntCmd(context.Background())
+		cmd.SetVal(1)
+		client.On("Del", mock.Anything, []string{"results:key"}).Once().Return(cmd)
+
+		// Act
+		s.Delete("key")
+
+		// Assert
+		client.AssertExpectations(t)
+	})
+}
+
+func Test_RedisKVStore_Scan(t *testing.T) {
+	t.Run("Returns the entries found once the limit is reached and the cursor SCAN returned", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		encoder := serialise.NewGobSerialiser[storedValue]()
+		s := NewRedisKVStore[storedValue](client, "results", encoder)
+
+		encoded, _ := encoder.Serialise(storedValue{Name: "foo"})
+
+		scanCmd := redis.NewScanCmd(context.Background(), nil)
+		scanCmd.SetVal([]string{"results:a", "results:b"}, 42)
+		client.On("Scan", mock.Anything, uint64(7), "results:task\\**", int64(1)).Once().Return(scanCmd)
+
+		// The value of results:b expired between SCAN and MGET
+		mgetCmd := redis.NewSliceCmd(context.Background())
+		mgetCmd.SetVal([]any{string(encoded), nil})
+		client.On("MGet", mock.Anything, []string{"results:a", "results:b"}).Once().Return(mgetCmd)
+
+		// Act
+		entries, cursor, err := s.Scan("task*", "7", 1, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []Entry[storedValue]{{Key: "a", Value: storedValue{Name: "foo"}}}, entries)
+		assert.Equal(t, "42", cursor)
+	})
+
+	t.Run("Scans again until enough entries match", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		encoder := serialise.NewGobSerialiser[storedValue]()
+		s := NewRedisKVStore[storedValue](client, "results", encoder)
+
+		skipped, _ := encoder.Serialise(storedValue{Name: "skip"})
+		kept, _ := encoder.Serialise(storedValue{Name: "keep"})
+
+		firstScan := redis.NewScanCmd(context.Background(), nil)
+		firstScan.SetVal([]string{"results:a"}, 42)
+		client.On("Scan", mock.Anything, uint64(0), "results:*", int64(1)).Once().Return(firstScan)
+
+		secondScan := redis.NewScanCmd(context.Background(), nil)
+		secondScan.SetVal([]string{"results:b"}, 43)
+		client.On("Scan", mock.Anything, uint64(42), "results:*", int64(1)).Once().Return(secondScan)
+
+		firstGet := redis.NewSliceCmd(context.Background())
+		firstGet.SetVal([]any{string(skipped)})
+		client.On("MGet", mock.Anything, []string{"results:a"}).Once().Return(firstGet)
+
+		secondGet := redis.NewSliceCmd(context.Background())
+		secondGet.SetVal([]any{string(kept)})
+		client.On("MGet", mock.Anything, []string{"results:b"}).Once().Return(secondGet)
+
+		// Act
+		entries, cursor, err := s.Scan("", "", 1, func(v storedValue) bool { return v.Name == "keep" })
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []Entry[storedValue]{{Key: "b", Value: storedValue{Name: "keep"}}}, entries)
+		assert.Equal(t, "43", cursor)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("Scans until the end if there is no limit", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		encoder := serialise.NewGobSerialiser[storedValue]()
+		s := NewRedisKVStore[storedValue](client, "results", encoder)
+
+		encoded, _ := encoder.Serialise(storedValue{Name: "foo"})
+
+		firstScan := redis.NewScanCmd(context.Background(), nil)
+		firstScan.SetVal([]string{}, 42)
+		client.On("Scan", mock.Anything, uint64(0), "results:*", int64(100)).Once().Return(firstScan)
+
+		lastScan := redis.NewScanCmd(context.Background(), nil)
+		lastScan.SetVal([]string{"results:a"}, 0)
+		client.On("Scan", mock.Anything, uint64(42), "results:*", int64(100)).Once().Return(lastScan)
+
+		mgetCmd := redis.NewSliceCmd(context.Background())
+		mgetCmd.SetVal([]any{string(encoded)})
+		client.On("MGet", mock.Anything, []string{"results:a"}).Once().Return(mgetCmd)
+
+		// Act
+		entries, cursor, err := s.Scan("", "", 0, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []Entry[storedValue]{{Key: "a", Value: storedValue{Name: "foo"}}}, entries)
+		assert.Equal(t, "", cursor)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("Returns an error for a cursor it did not return", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		s := NewRedisKVStore[storedValue](client, "results", serialise.NewGobSerialiser[storedValue]())
+
+		// Act
+		_, _, err := s.Scan("", "not-a-cursor", 10, nil)
+
+		// Assert
+		assert.ErrorContains(t, err, `invalid cursor "not-a-cursor"`)
+	})
+}
+
+type mockRedisClient struct {
+	mock.Mock
+}
+
+func (m *mockRedisClient) Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd {
+	called := m.Called(ctx, key, value, expiration)
+	return called.Get(0).(*redis.StatusCmd)
+}
+
+func (m *mockRedisClient) Get(ctx context.Context, key string) *redis.StringCmd {
+	called := m.Called(ctx, key)
+	return called.Get(0).(*redis.StringCmd)
+}
+
+func (m *mockRedisClient) Del(ctx context.Context, keys ...string) *redis.IntCmd {
+	called := m.Called(ctx, keys)
+	return called.Get(0).(*redis.IntCmd)
+}
+
+func (m *mockRedisClient) Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd {
+	called := m.Called(ctx, cursor, match, count)
+	return called.Get(0).(*redis.ScanCmd)
+}
+
+func (m *mockRedisClient) MGet(ctx context.Context, keys ...string) *redis.SliceCmd {
+	called := m.Called(ctx, keys)
+	return called.Get(0).(*redis.SliceCmd)
+}
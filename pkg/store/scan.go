@@ -0,0 +1,79 @@
+package store
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Entry is a key and the value stored under it, as returned by Scan.
+type Entry[V any] struct {
+	Key   string
+	Value V
+}
+
+// keyIndex holds keys in sorted order, so that a page of a scan can be found without
+// sorting every key. Stores build it on their first scan and keep it up to date
+// from then on, so stores that are never scanned do not pay for it.
+type keyIndex struct {
+	keys []string
+}
+
+func newKeyIndex(keys []string) *keyIndex {
+	sort.Strings(keys)
+
+	return &keyIndex{keys: keys}
+}
+
+func (idx *keyIndex) add(key string) {
+	i, found := slices.BinarySearch(idx.keys, key)
+	if !found {
+		idx.keys = slices.Insert(idx.keys, i, key)
+	}
+}
+
+func (idx *keyIndex) remove(key string) {
+	i, found := slices.BinarySearch(idx.keys, key)
+	if found {
+		idx.keys = slices.Delete(idx.keys, i, i+1)
+	}
+}
+
+// scanIndex returns the entries whose keys are in the index, start with prefix and
+// sort after cursor, in order, up to limit of them. get returns the value stored
+// under a key, and whether it should be returned, which it should not be if it has
+// expired or does not match. It also returns the cursor for the next page, which is
+// the last key returned, or "" if no more entries would be returned. A limit of 0 or
+// less returns every entry.
+func scanIndex[V any](idx *keyIndex, prefix, cursor string, limit int, get func(key string) (V, bool)) ([]Entry[V], string) {
+	// Keys after the cursor that start with prefix are contiguous, from the first
+	// key after both
+	start, _ := slices.BinarySearch(idx.keys, max(prefix, cursor))
+
+	entries := []Entry[V]{}
+
+	for _, key := range idx.keys[start:] {
+		if !strings.HasPrefix(key, prefix) {
+			break
+		}
+
+		if key <= cursor {
+			continue
+		}
+
+		v, ok := get(key)
+		if !ok {
+			continue
+		}
+
+		// Only report a cursor if another entry would be returned after this page,
+		// so that no page but the last is empty
+		if limit > 0 && len(entries) == limit {
+			return entries, entries[limit-1].Key
+		}
+
+		entries = append(entries, Entry[V]{Key: key, Value: v})
+	}
+
+	return entries, ""
+}
@@ -0,0 +1,144 @@
+package timerheap
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// TimerHeap holds items until the time they are scheduled for, then hands them to a
+// callback. Items are kept in a min-heap ordered by due time, so a single timer is
+// enough to wait for the next item regardless of how many are scheduled.
+type TimerHeap[T any] struct {
+	mu    sync.Mutex
+	items items[T]
+	wake  chan struct{}
+	seq   uint64
+}
+
+// New creates an empty TimerHeap. Items are not fired until Run is called.
+func New[T any]() *TimerHeap[T] {
+	return &TimerHeap[T]{
+		wake: make(chan struct{}, 1),
+	}
+}
+
+// Schedule adds an item to the heap to be fired at the given time. Items scheduled
+// in the past are fired as soon as possible. Items due at the same time are fired
+// in the order they were scheduled.
+func (h *TimerHeap[T]) Schedule(item T, at time.Time) {
+	h.mu.Lock()
+	h.seq++
+	heap.Push(&h.items, entry[T]{item: item, at: at, seq: h.seq})
+	h.mu.Unlock()
+
+	// Wake the run loop in case the new item is due before the one it is waiting on
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Len returns the number of items waiting to be fired.
+func (h *TimerHeap[T]) Len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.items.Len()
+}
+
+// Run fires items as they become due until the context is cancelled. fire is called
+// from the goroutine running Run, one item at a time. Items still waiting when the
+// context is cancelled are not fired.
+func (h *TimerHeap[T]) Run(ctx context.Context, fire func(T)) {
+	for {
+		due, next, waiting := h.popDue(time.Now())
+
+		for _, item := range due {
+			fire(item)
+		}
+
+		var timer *time.Timer
+
+		var timerC <-chan time.Time
+
+		if waiting {
+			timer = time.NewTimer(time.Until(next))
+			timerC = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			stopTimer(timer)
+
+			return
+		case <-h.wake:
+		case <-timerC:
+		}
+
+		stopTimer(timer)
+	}
+}
+
+func stopTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
+
+// popDue removes and returns every item due at or before now. It also returns the
+// due time of the next waiting item, if there is one.
+func (h *TimerHeap[T]) popDue(now time.Time) ([]T, time.Time, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var due []T
+
+	for h.items.Len() > 0 && !h.items[0].at.After(now) {
+		e, _ := heap.Pop(&h.items).(entry[T])
+		due = append(due, e.item)
+	}
+
+	if h.items.Len() == 0 {
+		return due, time.Time{}, false
+	}
+
+	return due, h.items[0].at, true
+}
+
+type entry[T any] struct {
+	item T
+	at   time.Time
+	seq  uint64
+}
+
+// items implements heap.Interface, ordering entries by due time and then by the
+// order in which they were scheduled.
+type items[T any] []entry[T]
+
+func (it items[T]) Len() int { return len(it) }
+
+func (it items[T]) Less(i, j int) bool {
+	if it[i].at.Equal(it[j].at) {
+		return it[i].seq < it[j].seq
+	}
+
+	return it[i].at.Before(it[j].at)
+}
+
+func (it items[T]) Swap(i, j int) { it[i], it[j] = it[j], it[i] }
+
+func (it *items[T]) Push(x any) {
+	e, _ := x.(entry[T])
+	*it = append(*it, e)
+}
+
+func (it *items[T]) Pop() any {
+	old := *it
+	n := len(old)
+	e := old[n-1]
+	*it = old[:n-1]
+
+	return e
+}
@@ -0,0 +1,125 @@
+//go:build unit
+
+package timerheap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TimerHeap_Schedule(t *testing.T) {
+	t.Run("Adds the item to the heap", func(t *testing.T) {
+		// Arrange
+		h := New[string]()
+
+		// Act
+		h.Schedule("later", time.Now().Add(time.Hour))
+		h.Schedule("sooner", time.Now().Add(time.Minute))
+
+		// Assert
+		assert.Equal(t, 2, h.Len())
+		assert.Equal(t, "sooner", h.items[0].item)
+	})
+}
+
+func Test_TimerHeap_Run(t *testing.T) {
+	t.Run("Fires items in due order once they are due", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		h := New[string]()
+		now := time.Now()
+
+		h.Schedule("third", now.Add(30*time.Millisecond))
+		h.Schedule("first", now.Add(-time.Second))
+		h.Schedule("second", now.Add(10*time.Millisecond))
+
+		fired := make(chan string, 3)
+
+		// Act
+		go h.Run(ctx, func(item string) { fired <- item })
+
+		// Assert
+		assert.Equal(t, "first", <-fired)
+		assert.Equal(t, "second", <-fired)
+		assert.Equal(t, "third", <-fired)
+		assert.Equal(t, 0, h.Len())
+	})
+
+	t.Run("Fires items scheduled while running", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		h := New[string]()
+		h.Schedule("far", time.Now().Add(time.Hour))
+
+		fired := make(chan string, 1)
+
+		go h.Run(ctx, func(item string) { fired <- item })
+
+		// Act
+		h.Schedule("near", time.Now().Add(10*time.Millisecond))
+
+		// Assert
+		select {
+		case item := <-fired:
+			assert.Equal(t, "near", item)
+		case <-time.After(time.Second):
+			t.Fatal("item scheduled while running was not fired")
+		}
+	})
+
+	t.Run("Fires items due at the same time in the order they were scheduled", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		h := New[int]()
+		at := time.Now()
+
+		for i := 0; i < 5; i++ {
+			h.Schedule(i, at)
+		}
+
+		fired := make(chan int, 5)
+
+		// Act
+		go h.Run(ctx, func(item int) { fired <- item })
+
+		// Assert
+		for i := 0; i < 5; i++ {
+			assert.Equal(t, i, <-fired)
+		}
+	})
+
+	t.Run("Returns when the context is cancelled without firing waiting items", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		h := New[string]()
+		h.Schedule("far", time.Now().Add(time.Hour))
+
+		done := make(chan struct{})
+
+		// Act
+		go func() {
+			h.Run(ctx, func(string) { t.Error("item should not be fired") })
+			close(done)
+		}()
+
+		cancel()
+
+		// Assert
+		select {
+		case <-done:
+			assert.Equal(t, 1, h.Len())
+		case <-time.After(time.Second):
+			t.Fatal("Run did not return after the context was cancelled")
+		}
+	})
+}
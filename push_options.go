@@ -0,0 +1,126 @@
+package goflow
+
+import (
+	"maps"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+// A PushOption configures a single task submitted with Push.
+type PushOption interface {
+	apply(*pushOptions)
+}
+
+type pushOptions struct {
+	timeout        time.Duration
+	runAt          time.Time
+	priority       int
+	callback       func(task.Result)
+	idempotencyKey string
+	metadata       map[string]string
+}
+
+type taskTimeoutOption struct {
+	Timeout time.Duration
+}
+
+func (t taskTimeoutOption) apply(opts *pushOptions) {
+	opts.timeout = t.Timeout
+}
+
+// WithTaskTimeout sets the maximum time the task's handler may run for. When the
+// timeout passes, the worker writes a timeout result and moves on to the next task.
+// Overrides the default set with WithDefaultTaskTimeout.
+func WithTaskTimeout(timeout time.Duration) PushOption {
+	return taskTimeoutOption{Timeout: timeout}
+}
+
+type runAtOption struct {
+	RunAt time.Time
+}
+
+func (r runAtOption) apply(opts *pushOptions) {
+	opts.runAt = r.RunAt
+}
+
+// WithRunAt holds the task back until the given time. The task broker must
+// implement task.DelayedSubmitter.
+func WithRunAt(at time.Time) PushOption {
+	return runAtOption{RunAt: at}
+}
+
+// WithDelay holds the task back until the given duration has passed. The task
+// broker must implement task.DelayedSubmitter.
+func WithDelay(delay time.Duration) PushOption {
+	return runAtOption{RunAt: time.Now().Add(delay)}
+}
+
+type priorityOption struct {
+	Priority int
+}
+
+func (p priorityOption) apply(opts *pushOptions) {
+	opts.priority = p.Priority
+}
+
+// WithPriority sets the task's priority, from 0 (the default) to task.MaxPriority.
+// Higher priority tasks are dequeued first.
+func WithPriority(priority int) PushOption {
+	return priorityOption{Priority: priority}
+}
+
+type callbackOption struct {
+	Callback func(task.Result)
+}
+
+func (c callbackOption) apply(opts *pushOptions) {
+	opts.callback = c.Callback
+}
+
+// WithCallback registers a function that is called with the task's result once it
+// has finished. Like the hooks set with WithOnResult, it runs in its own goroutine
+// and a panic is recovered. It is not called if GoFlow is closed first.
+func WithCallback(fn func(task.Result)) PushOption {
+	return callbackOption{Callback: fn}
+}
+
+type idempotencyKeyOption struct {
+	Key string
+}
+
+func (i idempotencyKeyOption) apply(opts *pushOptions) {
+	opts.idempotencyKey = i.Key
+}
+
+// WithIdempotencyKey pushes the task only if no other task has been pushed with the
+// same key within the idempotency window (see WithIdempotencyWindow). Otherwise the
+// ID of the original task is returned and nothing is enqueued, so a client can
+// safely repeat a push that it does not know succeeded. A callback set with
+// WithCallback is attached to the original task.
+func WithIdempotencyKey(key string) PushOption {
+	return idempotencyKeyOption{Key: key}
+}
+
+type metadataOption struct {
+	Metadata map[string]string
+}
+
+func (m metadataOption) apply(opts *pushOptions) {
+	if len(m.Metadata) == 0 {
+		return
+	}
+
+	if opts.metadata == nil {
+		opts.metadata = make(map[string]string, len(m.Metadata))
+	}
+
+	maps.Copy(opts.metadata, m.Metadata)
+}
+
+// WithMetadata attaches metadata to the task, such as a trace ID or tenant, which
+// handlers read from task.Task and hooks from task.Result. It is copied, and when
+// given more than once the maps are merged, later values winning.
+func WithMetadata(metadata map[string]string) PushOption {
+	return metadataOption{Metadata: metadata}
+}
@@ -0,0 +1,94 @@
+// Package ratelimit provides token bucket rate limiters, used by the worker pool to
+// limit how often tasks of a given type are executed.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit is a token bucket: Rate tokens are added to the bucket every second, up to
+// Burst tokens. Each execution takes one token. Rate must be positive.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// ErrInvalidRate is returned for a Limit whose Rate is not positive.
+var ErrInvalidRate = errors.New("rate limit rate must be positive")
+
+// Validate returns ErrInvalidRate if the limit's rate is not positive.
+func (l Limit) Validate() error {
+	// Written so that a NaN rate is rejected too
+	if !(l.Rate > 0) {
+		return ErrInvalidRate
+	}
+
+	return nil
+}
+
+// burst returns the size of the bucket, which always holds at least one token.
+func (l Limit) burst() float64 {
+	return math.Max(1, float64(l.Burst))
+}
+
+// Limiter holds a token bucket for each key. Several workers may share a limiter,
+// so Take must be atomic.
+type Limiter interface {
+	// Take removes a token from the key's bucket and returns zero if one is
+	// available. Otherwise it takes nothing and returns how long until a token will
+	// be available.
+	Take(ctx context.Context, key string, limit Limit) (time.Duration, error)
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// InMemoryLimiter is a Limiter for a single process.
+type InMemoryLimiter struct {
+	buckets map[string]*bucket
+	mu      sync.Mutex
+	now     func() time.Time
+}
+
+func NewInMemoryLimiter() *InMemoryLimiter {
+	return &InMemoryLimiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (l *InMemoryLimiter) Take(_ context.Context, key string, limit Limit) (time.Duration, error) {
+	if err := limit.Validate(); err != nil {
+		return 0, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: limit.burst(), last: now}
+		l.buckets[key] = b
+	}
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(limit.burst(), b.tokens+elapsed.Seconds()*limit.Rate)
+		b.last = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+
+		return 0, nil
+	}
+
+	return time.Duration(math.Ceil((1 - b.tokens) / limit.Rate * float64(time.Second))), nil
+}
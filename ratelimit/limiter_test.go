@@ -0,0 +1,131 @@
+//go:build unit
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InMemoryLimiter_Take(t *testing.T) {
+	t.Run("Allows a burst and then returns the wait for the next token", func(t *testing.T) {
+		// Arrange
+		now := time.Now()
+
+		l := NewInMemoryLimiter()
+		l.now = func() time.Time { return now }
+
+		ctx := context.Background()
+		limit := Limit{Rate: 2, Burst: 3}
+
+		// Act
+		var waits []time.Duration
+
+		for i := 0; i < 4; i++ {
+			wait, err := l.Take(ctx, "key", limit)
+			assert.NoError(t, err)
+
+			waits = append(waits, wait)
+		}
+
+		// Assert
+		assert.Equal(t, []time.Duration{0, 0, 0, 500 * time.Millisecond}, waits)
+	})
+
+	t.Run("Refills the bucket over time up to the burst", func(t *testing.T) {
+		// Arrange
+		now := time.Now()
+
+		l := NewInMemoryLimiter()
+		l.now = func() time.Time { return now }
+
+		ctx := context.Background()
+		limit := Limit{Rate: 1, Burst: 2}
+
+		_, _ = l.Take(ctx, "key", limit)
+		_, _ = l.Take(ctx, "key", limit)
+
+		now = now.Add(time.Hour)
+
+		// Act
+		first, _ := l.Take(ctx, "key", limit)
+		second, _ := l.Take(ctx, "key", limit)
+		third, _ := l.Take(ctx, "key", limit)
+
+		// Assert
+		assert.Zero(t, first)
+		assert.Zero(t, second)
+		assert.Equal(t, time.Second, third)
+	})
+
+	t.Run("Keeps a separate bucket for each key", func(t *testing.T) {
+		// Arrange
+		l := NewInMemoryLimiter()
+		l.now = func() time.Time { return time.Unix(0, 0) }
+
+		ctx := context.Background()
+		limit := Limit{Rate: 1, Burst: 1}
+
+		_, _ = l.Take(ctx, "first", limit)
+
+		// Act
+		wait, err := l.Take(ctx, "second", limit)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Zero(t, wait)
+	})
+
+	t.Run("Treats a burst below one as one", func(t *testing.T) {
+		// Arrange
+		l := NewInMemoryLimiter()
+		l.now = func() time.Time { return time.Unix(0, 0) }
+
+		ctx := context.Background()
+		limit := Limit{Rate: 4}
+
+		// Act
+		first, _ := l.Take(ctx, "key", limit)
+		second, _ := l.Take(ctx, "key", limit)
+
+		// Assert
+		assert.Zero(t, first)
+		assert.Equal(t, 250*time.Millisecond, second)
+	})
+}
+
+func Test_Limit_Validate(t *testing.T) {
+	t.Run("Accepts a positive rate", func(t *testing.T) {
+		// Act
+		err := Limit{Rate: 0.5}.Validate()
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Rejects a rate that is not positive", func(t *testing.T) {
+		for _, rate := range []float64{0, -1, math.NaN()} {
+			// Act
+			err := Limit{Rate: rate, Burst: 1}.Validate()
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidRate)
+		}
+	})
+
+	t.Run("Take returns an error instead of dividing by a rate that is not positive", func(t *testing.T) {
+		// Arrange
+		l := NewInMemoryLimiter()
+
+		// Act
+		wait, err := l.Take(context.Background(), "key", Limit{Burst: 1})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidRate)
+		assert.Zero(t, wait)
+	})
+}
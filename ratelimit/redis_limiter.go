@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type redisClient interface {
+	Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd
+}
+
+// takeScript refills the bucket in the hash KEYS[1] at ARGV[1] tokens per
+// millisecond, up to ARGV[2] tokens, as of ARGV[3] (unix milliseconds). It then
+// takes a token and returns 0 if one is available, or otherwise returns the number
+// of milliseconds until one will be. Idle buckets expire once they would be full.
+const takeScript = `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'last')
+local tokens = tonumber(bucket[1])
+local last = tonumber(bucket[2])
+
+if not tokens then
+	tokens = burst
+	last = now
+end
+
+if now > last then
+	tokens = math.min(burst, tokens + (now - last) * rate)
+	last = now
+end
+
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+else
+	wait = math.ceil((1 - tokens) / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'last', last)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate) + 1000)
+
+return wait
+`
+
+// RedisLimiter is a Limiter shared by every process connected to the same Redis, so
+// that a limit applies across every worker pool replica. Buckets are refilled using
+// the clock of the process taking a token, so replicas' clocks should be in sync.
+type RedisLimiter struct {
+	client redisClient
+	prefix string
+	now    func() time.Time
+}
+
+// NewRedisLimiter creates a RedisLimiter that keeps the bucket for each key under
+// prefix + ":" + key.
+func NewRedisLimiter(client redisClient, prefix string) *RedisLimiter {
+	return &RedisLimiter{client: client, prefix: prefix, now: time.Now}
+}
+
+func (r *RedisLimiter) Take(ctx context.Context, key string, limit Limit) (time.Duration, error) {
+	if err := limit.Validate(); err != nil {
+		return 0, err
+	}
+
+	waitMillis, err := r.client.Eval(
+		ctx,
+		takeScript,
+		[]string{r.prefix + ":" + key},
+		limit.Rate/float64(time.Second/time.Millisecond),
+		limit.burst(),
+		r.now().UnixMilli(),
+	).Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(waitMillis) * time.Millisecond, nil
+}
@@ -0,0 +1,78 @@
+//go:build unit
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_RedisLimiter_Take(t *testing.T) {
+	tests := []struct {
+		name       string
+		waitMillis int64
+		want       time.Duration
+	}{
+		{"Returns zero when a token is taken", 0, 0},
+		{"Returns the wait for the next token", 250, 250 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			now := time.UnixMilli(1700000000000)
+
+			client := new(mockRedisClient)
+			limiter := NewRedisLimiter(client, "ratelimit")
+			limiter.now = func() time.Time { return now }
+
+			ctx := context.Background()
+
+			cmd := redis.NewCmd(ctx)
+			cmd.SetVal(tt.waitMillis)
+			client.On("Eval", ctx, takeScript, []string{"ratelimit:key"}, []any{0.004, float64(10), now.UnixMilli()}).
+				Once().
+				Return(cmd)
+
+			// Act
+			wait, err := limiter.Take(ctx, "key", Limit{Rate: 4, Burst: 10})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, wait)
+			client.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns an error if redis fails", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		limiter := NewRedisLimiter(client, "ratelimit")
+
+		ctx := context.Background()
+
+		cmd := redis.NewCmd(ctx)
+		cmd.SetErr(redis.ErrClosed)
+		client.On("Eval", ctx, takeScript, mock.Anything, mock.Anything).Once().Return(cmd)
+
+		// Act
+		_, err := limiter.Take(ctx, "key", Limit{Rate: 1, Burst: 1})
+
+		// Assert
+		assert.ErrorIs(t, err, redis.ErrClosed)
+	})
+}
+
+type mockRedisClient struct {
+	mock.Mock
+}
+
+func (m *mockRedisClient) Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd {
+	called := m.Called(ctx, script, keys, args)
+	return called.Get(0).(*redis.Cmd)
+}
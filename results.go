@@ -0,0 +1,84 @@
+package goflow
+
+import (
+	"time"
+
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+// ResultFilter selects the results returned by ListResults. Fields left at their zero
+// value match every result.
+type ResultFilter struct {
+	TaskType string
+
+	// State is the state the task finished in: succeeded, failed or cancelled.
+	State task.State
+
+	// FinishedAfter and FinishedBefore bound when the task finished. FinishedAfter is
+	// inclusive and FinishedBefore exclusive.
+	FinishedAfter  time.Time
+	FinishedBefore time.Time
+
+	// Metadata matches results whose metadata holds every one of its keys with the
+	// same value.
+	Metadata map[string]string
+}
+
+func (f ResultFilter) matches(result task.Result) bool {
+	status := result.Status()
+
+	switch {
+	case f.TaskType != "" && status.TaskType != f.TaskType:
+		return false
+
+	case f.State != "" && status.State != f.State:
+		return false
+
+	case !f.FinishedAfter.IsZero() && status.FinishedAt.Before(f.FinishedAfter):
+		return false
+
+	case !f.FinishedBefore.IsZero() && !status.FinishedAt.Before(f.FinishedBefore):
+		return false
+	}
+
+	for key, value := range f.Metadata {
+		if v, ok := result.Metadata[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ListResults returns a page of the results in the results store that match the
+// filter, along with the cursor for the next page. The first page is returned for an
+// empty cursor, and each later page for the cursor returned with the page before it.
+// The cursor returned with the last page is empty.
+//
+// The filter is applied by the store as it scans, so only the last page holds fewer
+// than limit results, although some stores may return more. A limit of 0 or less
+// returns every matching result at once. It returns ErrListUnsupported if the
+// results store does not implement ScanKVStore.
+func (gf *GoFlow) ListResults(filter ResultFilter, cursor string, limit int) ([]task.Result, string, error) {
+	if !gf.isStarted() {
+		return nil, "", ErrNotStarted
+	}
+
+	scanner, ok := gf.results.(ScanKVStore[task.Result])
+	if !ok {
+		return nil, "", ErrListUnsupported
+	}
+
+	entries, next, err := scanner.Scan("", cursor, limit, filter.matches)
+	if err != nil {
+		return nil, "", err
+	}
+
+	results := make([]task.Result, 0, len(entries))
+
+	for _, entry := range entries {
+		results = append(results, entry.Value)
+	}
+
+	return results, next, nil
+}
@@ -0,0 +1,136 @@
+//go:build unit
+
+package goflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/pkg/store"
+	"github.com/jamesTait-jt/goflow/task"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GoFlow_ListResults(t *testing.T) {
+	now := time.Now()
+
+	succeeded := task.Result{TaskID: "a", TaskType: "resize", State: task.StateSucceeded, FinishedAt: now.Add(-time.Hour)}
+	failed := task.Result{TaskID: "b", TaskType: "resize", State: task.StateFailed, ErrMsg: "failed", FinishedAt: now}
+	otherType := task.Result{
+		TaskID:     "c",
+		TaskType:   "email",
+		State:      task.StateFailed,
+		ErrMsg:     "failed",
+		FinishedAt: now,
+		Metadata:   map[string]string{"tenant": "acme", "trace_id": "abc"},
+	}
+
+	// Older results have no state, which is worked out from their error message
+	stateless := task.Result{TaskID: "d", TaskType: "resize", ErrMsg: "failed", FinishedAt: now}
+
+	newGoFlow := func() *GoFlow {
+		results := store.NewInMemoryKVStore[string, task.Result]()
+		for _, r := range []task.Result{succeeded, failed, otherType, stateless} {
+			results.Put(r.TaskID, r)
+		}
+
+		return &GoFlow{results: results, started: true}
+	}
+
+	tests := []struct {
+		name     string
+		filter   ResultFilter
+		expected []task.Result
+	}{
+		{
+			name:     "Lists every result without a filter",
+			filter:   ResultFilter{},
+			expected: []task.Result{succeeded, failed, otherType, stateless},
+		},
+		{
+			name:     "Filters by task type and state",
+			filter:   ResultFilter{TaskType: "resize", State: task.StateFailed},
+			expected: []task.Result{failed, stateless},
+		},
+		{
+			name:     "Filters by when the task finished",
+			filter:   ResultFilter{FinishedAfter: now.Add(-2 * time.Hour), FinishedBefore: now},
+			expected: []task.Result{succeeded},
+		},
+		{
+			name:     "Filters by metadata",
+			filter:   ResultFilter{Metadata: map[string]string{"tenant": "acme"}},
+			expected: []task.Result{otherType},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			gf := newGoFlow()
+
+			// Act
+			results, cursor, err := gf.ListResults(tt.filter, "", 0)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, results)
+			assert.Equal(t, "", cursor)
+		})
+	}
+
+	t.Run("Pages through the matching results, filling every page but the last", func(t *testing.T) {
+		// Arrange
+		gf := newGoFlow()
+		filter := ResultFilter{State: task.StateFailed}
+
+		// Act
+		first, cursor, firstErr := gf.ListResults(filter, "", 2)
+		second, last, secondErr := gf.ListResults(filter, cursor, 2)
+
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.Equal(t, []task.Result{failed, otherType}, first)
+		assert.Equal(t, "c", cursor)
+
+		assert.NoError(t, secondErr)
+		assert.Equal(t, []task.Result{stateless}, second)
+		assert.Equal(t, "", last)
+	})
+
+	t.Run("Does not return a cursor for an empty page", func(t *testing.T) {
+		// Arrange
+		gf := newGoFlow()
+
+		// Act
+		results, cursor, err := gf.ListResults(ResultFilter{TaskType: "email"}, "", 1)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []task.Result{otherType}, results)
+		assert.Equal(t, "", cursor)
+	})
+
+	t.Run("Returns ErrListUnsupported if the results store cannot be scanned", func(t *testing.T) {
+		// Arrange
+		gf := &GoFlow{results: new(mockKVStore[string, task.Result]), started: true}
+
+		// Act
+		_, _, err := gf.ListResults(ResultFilter{}, "", 0)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrListUnsupported)
+	})
+
+	t.Run("Returns ErrNotStarted if GoFlow instance isn't started", func(t *testing.T) {
+		// Arrange
+		gf := newGoFlow()
+		gf.started = false
+
+		// Act
+		_, _, err := gf.ListResults(ResultFilter{}, "", 0)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotStarted)
+	})
+}
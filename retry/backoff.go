@@ -0,0 +1,70 @@
+package retry
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff computes how long to wait before a failed task is attempted again.
+type Backoff interface {
+	// Delay returns the wait before the next attempt, given the number of attempts
+	// that have already been made (starting at 1).
+	Delay(attempt int) time.Duration
+}
+
+// BackoffFunc allows an ordinary function to be used as a Backoff.
+type BackoffFunc func(attempt int) time.Duration
+
+// Delay calls f(attempt).
+func (f BackoffFunc) Delay(attempt int) time.Duration {
+	return f(attempt)
+}
+
+// Fixed waits the same amount of time before every retry.
+func Fixed(delay time.Duration) Backoff {
+	return BackoffFunc(func(_ int) time.Duration {
+		return delay
+	})
+}
+
+// Exponential doubles the wait after every attempt, starting at base. If maxDelay
+// is greater than zero, the wait never exceeds it, and otherwise it stops growing at
+// the longest time.Duration.
+func Exponential(base, maxDelay time.Duration) Backoff {
+	limit := time.Duration(math.MaxInt64)
+	if maxDelay > 0 {
+		limit = maxDelay
+	}
+
+	return BackoffFunc(func(attempt int) time.Duration {
+		if base <= 0 {
+			return 0
+		}
+
+		shift := max(attempt-1, 0)
+
+		// Checked before shifting, as a shifted delay that overflows can wrap round
+		// to any value, including one that looks valid
+		if shift >= 63 || base > limit>>shift {
+			return limit
+		}
+
+		return base << shift
+	})
+}
+
+// Jittered randomises the wait of another Backoff to somewhere between zero and
+// the wrapped delay ("full jitter"). This spreads out retries of tasks that failed
+// at the same time, e.g. because a downstream dependency was unavailable.
+func Jittered(b Backoff) Backoff {
+	return BackoffFunc(func(attempt int) time.Duration {
+		delay := b.Delay(attempt)
+		if delay <= 0 {
+			return 0
+		}
+
+		// nolint:gosec // jitter does not need a cryptographically secure source
+		return time.Duration(rand.Int64N(int64(delay) + 1))
+	})
+}
@@ -0,0 +1,54 @@
+package retry
+
+import (
+	"time"
+
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+// Classifier reports whether a failed result is worth retrying. It is only
+// consulted for results with a non-empty ErrMsg.
+type Classifier func(result task.Result) bool
+
+// Policy describes how failed tasks of a single task type are retried.
+type Policy struct {
+	// MaxAttempts is the total number of times a task will be attempted, including
+	// the first attempt. Values below 2 disable retries.
+	MaxAttempts int
+
+	// Backoff decides how long to wait before the next attempt. A nil Backoff
+	// retries immediately.
+	Backoff Backoff
+
+	// Retryable decides which failures are retried. A nil Retryable retries every
+	// failure.
+	Retryable Classifier
+}
+
+// ShouldRetry reports whether the task should be attempted again, given the result
+// of its latest attempt. t.Attempt must hold the number of attempts made so far.
+func (p Policy) ShouldRetry(t task.Task, result task.Result) bool {
+	if result.ErrMsg == "" {
+		return false
+	}
+
+	if t.Attempt >= p.MaxAttempts {
+		return false
+	}
+
+	if p.Retryable != nil && !p.Retryable(result) {
+		return false
+	}
+
+	return true
+}
+
+// Delay returns how long to wait before the next attempt of a task that has been
+// attempted the given number of times.
+func (p Policy) Delay(attempt int) time.Duration {
+	if p.Backoff == nil {
+		return 0
+	}
+
+	return p.Backoff.Delay(attempt)
+}
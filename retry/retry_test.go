@@ -0,0 +1,134 @@
+//go:build unit
+
+package retry
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/task"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Policy_ShouldRetry(t *testing.T) {
+	type shouldRetryTest struct {
+		name    string
+		policy  Policy
+		attempt int
+		result  task.Result
+		want    bool
+	}
+
+	for _, tt := range []shouldRetryTest{
+		{"successful result", Policy{MaxAttempts: 3}, 1, task.Result{}, false},
+		{"attempts remaining", Policy{MaxAttempts: 3}, 2, task.Result{ErrMsg: "err"}, true},
+		{"attempts exhausted", Policy{MaxAttempts: 3}, 3, task.Result{ErrMsg: "err"}, false},
+		{"retries disabled", Policy{}, 1, task.Result{ErrMsg: "err"}, false},
+		{
+			"classifier rejects",
+			Policy{MaxAttempts: 3, Retryable: func(task.Result) bool { return false }},
+			1,
+			task.Result{ErrMsg: "err"},
+			false,
+		},
+		{
+			"classifier accepts",
+			Policy{MaxAttempts: 3, Retryable: func(task.Result) bool { return true }},
+			1,
+			task.Result{ErrMsg: "err"},
+			true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := tt.policy.ShouldRetry(task.Task{Attempt: tt.attempt}, tt.result)
+
+			// Assert
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_Policy_Delay(t *testing.T) {
+	t.Run("Returns zero without a backoff", func(t *testing.T) {
+		// Act
+		delay := Policy{}.Delay(3)
+
+		// Assert
+		assert.Equal(t, time.Duration(0), delay)
+	})
+
+	t.Run("Delegates to the backoff", func(t *testing.T) {
+		// Act
+		delay := Policy{Backoff: Fixed(time.Second)}.Delay(3)
+
+		// Assert
+		assert.Equal(t, time.Second, delay)
+	})
+}
+
+func Test_Exponential(t *testing.T) {
+	t.Run("Doubles the delay after every attempt", func(t *testing.T) {
+		// Arrange
+		b := Exponential(time.Second, 0)
+
+		// Act & Assert
+		assert.Equal(t, time.Second, b.Delay(1))
+		assert.Equal(t, 2*time.Second, b.Delay(2))
+		assert.Equal(t, 4*time.Second, b.Delay(3))
+	})
+
+	t.Run("Never exceeds the maximum delay", func(t *testing.T) {
+		// Arrange
+		b := Exponential(time.Second, 3*time.Second)
+
+		// Act & Assert
+		assert.Equal(t, 2*time.Second, b.Delay(2))
+		assert.Equal(t, 3*time.Second, b.Delay(3))
+		assert.Equal(t, 3*time.Second, b.Delay(1000))
+	})
+
+	t.Run("Stops growing at the longest duration instead of overflowing", func(t *testing.T) {
+		// Arrange
+		b := Exponential(3*time.Second, 0)
+
+		// Act & Assert
+		assert.Equal(t, 3*time.Second<<30, b.Delay(31))
+		assert.Equal(t, time.Duration(math.MaxInt64), b.Delay(33))
+		assert.Equal(t, time.Duration(math.MaxInt64), b.Delay(64))
+		assert.Equal(t, time.Duration(math.MaxInt64), b.Delay(1000))
+	})
+
+	t.Run("Caps a delay that would overflow at the maximum delay", func(t *testing.T) {
+		// Arrange
+		b := Exponential(3*time.Second, time.Hour)
+
+		// Act & Assert
+		assert.Equal(t, time.Hour, b.Delay(33))
+		assert.Equal(t, time.Hour, b.Delay(100))
+	})
+}
+
+func Test_Jittered(t *testing.T) {
+	t.Run("Returns a delay between zero and the wrapped delay", func(t *testing.T) {
+		// Arrange
+		b := Jittered(Fixed(time.Second))
+
+		// Act & Assert
+		for i := 0; i < 100; i++ {
+			delay := b.Delay(1)
+
+			assert.GreaterOrEqual(t, delay, time.Duration(0))
+			assert.LessOrEqual(t, delay, time.Second)
+		}
+	})
+
+	t.Run("Returns zero if the wrapped delay is zero", func(t *testing.T) {
+		// Act
+		delay := Jittered(Fixed(0)).Delay(1)
+
+		// Assert
+		assert.Equal(t, time.Duration(0), delay)
+	})
+}
@@ -0,0 +1,54 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/jamesTait-jt/goflow/pkg/log"
+)
+
+var defaultPollInterval = time.Second
+
+type schedulerOptions struct {
+	pollInterval time.Duration
+	logger       log.Logger
+}
+
+func defaultSchedulerOptions() schedulerOptions {
+	return schedulerOptions{
+		pollInterval: defaultPollInterval,
+		logger:       log.NewConsoleLogger(),
+	}
+}
+
+// An Option configures a Scheduler.
+type Option interface {
+	apply(*schedulerOptions)
+}
+
+type pollIntervalOption struct {
+	PollInterval time.Duration
+}
+
+func (p pollIntervalOption) apply(opts *schedulerOptions) {
+	opts.pollInterval = p.PollInterval
+}
+
+// WithPollInterval allows you to set how often the scheduler checks for due
+// schedules. Schedules fire up to one interval late. Defaults to one second.
+func WithPollInterval(interval time.Duration) Option {
+	return pollIntervalOption{PollInterval: interval}
+}
+
+type loggerOption struct {
+	Logger log.Logger
+}
+
+func (l loggerOption) apply(opts *schedulerOptions) {
+	opts.logger = l.Logger
+}
+
+// WithLogger allows you to set the logger used to report failures to fire
+// schedules.
+func WithLogger(logger log.Logger) Option {
+	return loggerOption{Logger: logger}
+}
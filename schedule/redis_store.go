@@ -0,0 +1,138 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type redisClient interface {
+	HGetAll(ctx context.Context, key string) *redis.MapStringStringCmd
+	ZRangeWithScores(ctx context.Context, key string, start, stop int64) *redis.ZSliceCmd
+	Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd
+}
+
+// addScript stores the encoded schedule (ARGV[2]) in the hash KEYS[1] and its next
+// run (ARGV[3], unix milliseconds) in the sorted set KEYS[2], both under the ID
+// ARGV[1].
+const addScript = `
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+redis.call('ZADD', KEYS[2], ARGV[3], ARGV[1])
+return 1
+`
+
+// removeScript deletes the schedule ARGV[1] from both keys, returning the number of
+// schedules removed.
+const removeScript = `
+redis.call('ZREM', KEYS[2], ARGV[1])
+return redis.call('HDEL', KEYS[1], ARGV[1])
+`
+
+// advanceScript moves the next run of schedule ARGV[1] from ARGV[2] to ARGV[3] if it
+// is still ARGV[2], returning 1 if it did. Only one caller can advance a given run,
+// which is what stops several replicas firing the same run.
+const advanceScript = `
+local score = redis.call('ZSCORE', KEYS[1], ARGV[1])
+if not score or tonumber(score) ~= tonumber(ARGV[2]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[3], ARGV[1])
+return 1
+`
+
+// Encoder serialises schedules so that they can be stored in Redis.
+type Encoder interface {
+	Serialise(toSerialise Schedule) ([]byte, error)
+	Deserialise(toDeserialise []byte) (Schedule, error)
+}
+
+// RedisStore is a Store shared by every process connected to the same Redis. Each
+// schedule is stored in a hash, and its next run in a sorted set, so that the next
+// run can be advanced atomically. Run times are stored to millisecond precision.
+type RedisStore struct {
+	client  redisClient
+	key     string
+	encoder Encoder
+}
+
+// NewRedisStore creates a RedisStore that keeps schedules under the given key, and
+// their next runs under key + ":next".
+func NewRedisStore(client redisClient, key string, encoder Encoder) *RedisStore {
+	return &RedisStore{client: client, key: key, encoder: encoder}
+}
+
+func (r *RedisStore) Add(ctx context.Context, s Schedule) error {
+	encoded, err := r.encoder.Serialise(s)
+	if err != nil {
+		return err
+	}
+
+	return r.client.Eval(ctx, addScript, []string{r.key, r.nextRunKey()}, s.ID, encoded, s.NextRun.UnixMilli()).Err()
+}
+
+func (r *RedisStore) Remove(ctx context.Context, id string) (bool, error) {
+	removed, err := r.client.Eval(ctx, removeScript, []string{r.key, r.nextRunKey()}, id).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return removed > 0, nil
+}
+
+func (r *RedisStore) List(ctx context.Context) ([]Schedule, error) {
+	encoded, err := r.client.HGetAll(ctx, r.key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	nextRuns, err := r.client.ZRangeWithScores(ctx, r.nextRunKey(), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]Schedule, 0, len(nextRuns))
+
+	// Schedules added or removed between the two reads are skipped until the next
+	// call
+	for _, nextRun := range nextRuns {
+		id, _ := nextRun.Member.(string)
+
+		value, ok := encoded[id]
+		if !ok {
+			continue
+		}
+
+		s, err := r.encoder.Deserialise([]byte(value))
+		if err != nil {
+			return nil, err
+		}
+
+		s.NextRun = time.UnixMilli(int64(nextRun.Score)).UTC()
+		schedules = append(schedules, s)
+	}
+
+	sortByID(schedules)
+
+	return schedules, nil
+}
+
+func (r *RedisStore) Advance(ctx context.Context, id string, from, to time.Time) (bool, error) {
+	advanced, err := r.client.Eval(
+		ctx,
+		advanceScript,
+		[]string{r.nextRunKey()},
+		id,
+		from.UnixMilli(),
+		to.UnixMilli(),
+	).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return advanced == 1, nil
+}
+
+func (r *RedisStore) nextRunKey() string {
+	return r.key + ":next"
+}
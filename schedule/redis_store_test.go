@@ -0,0 +1,157 @@
+//go:build unit
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/pkg/serialise"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_RedisStore_Add(t *testing.T) {
+	t.Run("Stores the encoded schedule and its next run", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		encoder := serialise.NewGobSerialiser[Schedule]()
+		store := NewRedisStore(client, "schedules", encoder)
+
+		ctx := context.Background()
+		s := Schedule{ID: "id", Spec: "@daily", TaskType: "report", Payload: "p", NextRun: time.UnixMilli(1000)}
+		encoded, _ := encoder.Serialise(s)
+
+		cmd := redis.NewCmd(ctx)
+		cmd.SetVal(int64(1))
+		client.On("Eval", ctx, addScript, []string{"schedules", "schedules:next"}, []any{"id", encoded, int64(1000)}).
+			Once().
+			Return(cmd)
+
+		// Act
+		err := store.Add(ctx, s)
+
+		// Assert
+		assert.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+}
+
+func Test_RedisStore_Remove(t *testing.T) {
+	t.Run("Returns whether a schedule was removed", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		store := NewRedisStore(client, "schedules", serialise.NewGobSerialiser[Schedule]())
+
+		ctx := context.Background()
+
+		cmd := redis.NewCmd(ctx)
+		cmd.SetVal(int64(1))
+		client.On("Eval", ctx, removeScript, []string{"schedules", "schedules:next"}, []any{"id"}).
+			Once().
+			Return(cmd)
+
+		// Act
+		removed, err := store.Remove(ctx, "id")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, removed)
+	})
+}
+
+func Test_RedisStore_List(t *testing.T) {
+	t.Run("Combines each schedule with its next run", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		encoder := serialise.NewGobSerialiser[Schedule]()
+		store := NewRedisStore(client, "schedules", encoder)
+
+		ctx := context.Background()
+		b, _ := encoder.Serialise(Schedule{ID: "b", Spec: "@daily", TaskType: "report"})
+		a, _ := encoder.Serialise(Schedule{ID: "a", Spec: "@hourly", TaskType: "other"})
+
+		hashCmd := redis.NewMapStringStringCmd(ctx)
+		hashCmd.SetVal(map[string]string{"a": string(a), "b": string(b)})
+		client.On("HGetAll", ctx, "schedules").Once().Return(hashCmd)
+
+		// "c" was added between the two reads so has no definition yet
+		zCmd := redis.NewZSliceCmd(ctx)
+		zCmd.SetVal([]redis.Z{{Member: "b", Score: 1000}, {Member: "a", Score: 2000}, {Member: "c", Score: 3000}})
+		client.On("ZRangeWithScores", ctx, "schedules:next", int64(0), int64(-1)).Once().Return(zCmd)
+
+		// Act
+		schedules, err := store.List(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []Schedule{
+			{ID: "a", Spec: "@hourly", TaskType: "other", NextRun: time.UnixMilli(2000).UTC()},
+			{ID: "b", Spec: "@daily", TaskType: "report", NextRun: time.UnixMilli(1000).UTC()},
+		}, schedules)
+	})
+
+	t.Run("Returns an error if reading the schedules fails", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		store := NewRedisStore(client, "schedules", serialise.NewGobSerialiser[Schedule]())
+
+		ctx := context.Background()
+		readErr := errors.New("read failed")
+
+		hashCmd := redis.NewMapStringStringCmd(ctx)
+		hashCmd.SetErr(readErr)
+		client.On("HGetAll", ctx, "schedules").Once().Return(hashCmd)
+
+		// Act
+		_, err := store.List(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, readErr)
+	})
+}
+
+func Test_RedisStore_Advance(t *testing.T) {
+	t.Run("Returns whether the next run was advanced", func(t *testing.T) {
+		// Arrange
+		client := new(mockRedisClient)
+		store := NewRedisStore(client, "schedules", serialise.NewGobSerialiser[Schedule]())
+
+		ctx := context.Background()
+
+		cmd := redis.NewCmd(ctx)
+		cmd.SetVal(int64(0))
+		client.On("Eval", ctx, advanceScript, []string{"schedules:next"}, []any{"id", int64(1000), int64(2000)}).
+			Once().
+			Return(cmd)
+
+		// Act
+		advanced, err := store.Advance(ctx, "id", time.UnixMilli(1000), time.UnixMilli(2000))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, advanced)
+	})
+}
+
+type mockRedisClient struct {
+	mock.Mock
+}
+
+func (m *mockRedisClient) HGetAll(ctx context.Context, key string) *redis.MapStringStringCmd {
+	args := m.Called(ctx, key)
+	return args.Get(0).(*redis.MapStringStringCmd)
+}
+
+func (m *mockRedisClient) ZRangeWithScores(ctx context.Context, key string, start, stop int64) *redis.ZSliceCmd {
+	args := m.Called(ctx, key, start, stop)
+	return args.Get(0).(*redis.ZSliceCmd)
+}
+
+func (m *mockRedisClient) Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd {
+	called := m.Called(ctx, script, keys, args)
+	return called.Get(0).(*redis.Cmd)
+}
@@ -0,0 +1,181 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+var (
+	ErrNeverRuns = errors.New("schedule spec never runs")
+	ErrNotFound  = errors.New("schedule not found")
+)
+
+// Scheduler fires the schedules in its store, submitting a task each time one is
+// due. Any number of schedulers may share a store: each run of a schedule is
+// fired by exactly one of them.
+//
+// A run that is missed, for example because no scheduler was running, is fired
+// once when a scheduler next checks the store. Further missed runs are skipped.
+type Scheduler struct {
+	store     Store
+	submitter task.Submitter[task.Task]
+	wg        *sync.WaitGroup
+	opts      schedulerOptions
+}
+
+// NewScheduler creates a Scheduler that submits tasks for the schedules in store to
+// submitter.
+func NewScheduler(store Store, submitter task.Submitter[task.Task], opt ...Option) *Scheduler {
+	opts := defaultSchedulerOptions()
+
+	for _, o := range opt {
+		o.apply(&opts)
+	}
+
+	return &Scheduler{
+		store:     store,
+		submitter: submitter,
+		wg:        &sync.WaitGroup{},
+		opts:      opts,
+	}
+}
+
+// Add registers a schedule that submits a task of the given type and payload each
+// time spec fires. See Parse for the accepted spec formats.
+func (s *Scheduler) Add(ctx context.Context, taskType string, payload any, spec string) (Schedule, error) {
+	parsed, err := Parse(spec)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	nextRun := parsed.Next(time.Now().UTC())
+	if nextRun.IsZero() {
+		return Schedule{}, fmt.Errorf("%w: %q", ErrNeverRuns, spec)
+	}
+
+	schedule := Schedule{
+		ID:       uuid.New().String(),
+		Spec:     spec,
+		TaskType: taskType,
+		Payload:  payload,
+		NextRun:  nextRun.Truncate(time.Millisecond),
+	}
+
+	err = s.store.Add(ctx, schedule)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	return schedule, nil
+}
+
+// Remove deletes the schedule with the given ID. Tasks it has already submitted are
+// unaffected.
+func (s *Scheduler) Remove(ctx context.Context, id string) error {
+	ok, err := s.store.Remove(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// List returns every registered schedule.
+func (s *Scheduler) List(ctx context.Context) ([]Schedule, error) {
+	return s.store.List(ctx)
+}
+
+// Start checks the store for due schedules every poll interval until the context is
+// cancelled. It is non-blocking.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.opts.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case now := <-ticker.C:
+				s.fireDue(ctx, now.UTC())
+			}
+		}
+	}()
+}
+
+// AwaitShutdown blocks until the scheduler has stopped after its context was
+// cancelled.
+func (s *Scheduler) AwaitShutdown() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) fireDue(ctx context.Context, now time.Time) {
+	schedules, err := s.store.List(ctx)
+	if err != nil {
+		s.opts.logger.Warn(fmt.Sprintf("failed to list schedules: %v", err))
+
+		return
+	}
+
+	for _, schedule := range schedules {
+		if schedule.NextRun.After(now) {
+			continue
+		}
+
+		claimed, err := s.claim(ctx, schedule, now)
+		if err != nil {
+			s.opts.logger.Warn(fmt.Sprintf("failed to claim schedule %s: %v", schedule.ID, err))
+
+			continue
+		}
+
+		if !claimed {
+			continue
+		}
+
+		t := task.New(schedule.TaskType, schedule.Payload)
+
+		err = s.submitter.Submit(ctx, t)
+		if err != nil {
+			s.opts.logger.Error(fmt.Sprintf("failed to submit task for schedule %s: %v", schedule.ID, err))
+		}
+	}
+}
+
+// claim advances the schedule past now, returning whether this scheduler won the
+// right to fire the due run. A schedule that will never run again is removed
+// instead.
+func (s *Scheduler) claim(ctx context.Context, schedule Schedule, now time.Time) (bool, error) {
+	spec, err := Parse(schedule.Spec)
+	if err != nil {
+		return false, err
+	}
+
+	// Runs missed while no scheduler was running are skipped in one step, however
+	// many there were, rather than stepping through each of them
+	next := spec.Next(schedule.NextRun)
+	if !next.IsZero() && !next.After(now) {
+		next = spec.Next(now)
+	}
+
+	if next.IsZero() {
+		return s.store.Remove(ctx, schedule.ID)
+	}
+
+	return s.store.Advance(ctx, schedule.ID, schedule.NextRun, next.Truncate(time.Millisecond))
+}
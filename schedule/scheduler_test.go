@@ -0,0 +1,261 @@
+//go:build unit
+
+package schedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/broker"
+	"github.com/jamesTait-jt/goflow/pkg/log"
+	"github.com/jamesTait-jt/goflow/task"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Scheduler_Add(t *testing.T) {
+	t.Run("Stores the schedule with its first run", func(t *testing.T) {
+		// Arrange
+		store := NewInMemoryStore()
+		scheduler := NewScheduler(store, broker.NewChannelBroker[task.Task](1))
+
+		ctx := context.Background()
+		before := time.Now()
+
+		// Act
+		added, err := scheduler.Add(ctx, "report", "payload", "@every 1h")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotEmpty(t, added.ID)
+		assert.Equal(t, "report", added.TaskType)
+		assert.Equal(t, "payload", added.Payload)
+		assert.WithinDuration(t, before.Add(time.Hour), added.NextRun, time.Second)
+
+		stored, _ := store.List(ctx)
+		assert.Equal(t, []Schedule{added}, stored)
+	})
+
+	t.Run("Returns an error if the spec is invalid", func(t *testing.T) {
+		// Arrange
+		store := NewInMemoryStore()
+		scheduler := NewScheduler(store, broker.NewChannelBroker[task.Task](1))
+
+		// Act
+		_, err := scheduler.Add(context.Background(), "report", "payload", "not a spec")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidSpec)
+	})
+
+	t.Run("Returns an error if the spec never runs", func(t *testing.T) {
+		// Arrange
+		store := NewInMemoryStore()
+		scheduler := NewScheduler(store, broker.NewChannelBroker[task.Task](1))
+
+		// Act
+		_, err := scheduler.Add(context.Background(), "report", "payload", "0 0 31 4 *")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNeverRuns)
+	})
+}
+
+func Test_Scheduler_Remove(t *testing.T) {
+	t.Run("Removes the schedule", func(t *testing.T) {
+		// Arrange
+		store := NewInMemoryStore()
+		scheduler := NewScheduler(store, broker.NewChannelBroker[task.Task](1))
+
+		ctx := context.Background()
+		added, _ := scheduler.Add(ctx, "report", "payload", "@daily")
+
+		// Act
+		err := scheduler.Remove(ctx, added.ID)
+
+		// Assert
+		assert.NoError(t, err)
+
+		stored, _ := scheduler.List(ctx)
+		assert.Empty(t, stored)
+	})
+
+	t.Run("Returns an error if the schedule does not exist", func(t *testing.T) {
+		// Arrange
+		scheduler := NewScheduler(NewInMemoryStore(), broker.NewChannelBroker[task.Task](1))
+
+		// Act
+		err := scheduler.Remove(context.Background(), "missing")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func Test_Scheduler_fireDue(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	t.Run("Submits a task for each due schedule and advances it", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		store := NewInMemoryStore()
+		taskQueue := broker.NewChannelBroker[task.Task](2)
+		scheduler := NewScheduler(store, taskQueue)
+
+		_ = store.Add(ctx, Schedule{ID: "due", Spec: "*/15 * * * *", TaskType: "report", Payload: "p", NextRun: now})
+		_ = store.Add(ctx, Schedule{ID: "later", Spec: "@hourly", TaskType: "other", NextRun: now.Add(time.Minute)})
+
+		// Act
+		scheduler.fireDue(ctx, now)
+
+		// Assert
+		submitted := <-taskQueue.Dequeue(ctx)
+		assert.Equal(t, "report", submitted.Type)
+		assert.Equal(t, "p", submitted.Payload)
+		assert.NotEmpty(t, submitted.ID)
+
+		select {
+		case unexpected := <-taskQueue.Dequeue(ctx):
+			t.Fatalf("unexpected task submitted: %v", unexpected)
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		stored, _ := store.List(ctx)
+		assert.Equal(t, now.Add(15*time.Minute), stored[0].NextRun)
+		assert.Equal(t, now.Add(time.Minute), stored[1].NextRun)
+	})
+
+	t.Run("Fires missed runs once and skips to the next future run", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		store := NewInMemoryStore()
+		taskQueue := broker.NewChannelBroker[task.Task](5)
+		scheduler := NewScheduler(store, taskQueue)
+
+		_ = store.Add(ctx, Schedule{ID: "missed", Spec: "@every 1m", TaskType: "report", NextRun: now.Add(-time.Hour)})
+
+		// Act
+		scheduler.fireDue(ctx, now)
+
+		// Assert
+		<-taskQueue.Dequeue(ctx)
+
+		select {
+		case unexpected := <-taskQueue.Dequeue(ctx):
+			t.Fatalf("unexpected task submitted: %v", unexpected)
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		stored, _ := store.List(ctx)
+		assert.Equal(t, now.Add(time.Minute), stored[0].NextRun)
+	})
+
+	t.Run("Skips runs missed over a long time in one step", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		store := NewInMemoryStore()
+		taskQueue := broker.NewChannelBroker[task.Task](5)
+		scheduler := NewScheduler(store, taskQueue)
+
+		_ = store.Add(ctx, Schedule{
+			ID:       "missed",
+			Spec:     "@every 1s",
+			TaskType: "report",
+			NextRun:  now.AddDate(-10, 0, 0),
+		})
+
+		// Act
+		scheduler.fireDue(ctx, now)
+
+		// Assert
+		<-taskQueue.Dequeue(ctx)
+
+		stored, _ := store.List(ctx)
+		assert.Equal(t, now.Add(time.Second), stored[0].NextRun)
+	})
+
+	t.Run("Keeps an interval schedule fired late on its original times", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		store := NewInMemoryStore()
+		taskQueue := broker.NewChannelBroker[task.Task](5)
+		scheduler := NewScheduler(store, taskQueue)
+
+		_ = store.Add(ctx, Schedule{ID: "late", Spec: "@every 1m", TaskType: "report", NextRun: now.Add(-10 * time.Second)})
+
+		// Act
+		scheduler.fireDue(ctx, now)
+
+		// Assert
+		<-taskQueue.Dequeue(ctx)
+
+		stored, _ := store.List(ctx)
+		assert.Equal(t, now.Add(50*time.Second), stored[0].NextRun)
+	})
+
+	t.Run("Fires each run once across schedulers sharing a store", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		store := NewInMemoryStore()
+		taskQueue := broker.NewChannelBroker[task.Task](5)
+		first := NewScheduler(store, taskQueue)
+		second := NewScheduler(store, taskQueue)
+
+		_ = store.Add(ctx, Schedule{ID: "shared", Spec: "@every 1m", TaskType: "report", NextRun: now})
+
+		// Act
+		first.fireDue(ctx, now)
+		second.fireDue(ctx, now)
+
+		// Assert
+		<-taskQueue.Dequeue(ctx)
+
+		select {
+		case unexpected := <-taskQueue.Dequeue(ctx):
+			t.Fatalf("unexpected task submitted: %v", unexpected)
+		case <-time.After(10 * time.Millisecond):
+		}
+	})
+
+	t.Run("Logs and skips schedules with an invalid spec", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		store := NewInMemoryStore()
+		taskQueue := broker.NewChannelBroker[task.Task](1)
+		logger := new(log.TestifyMock)
+		scheduler := NewScheduler(store, taskQueue, WithLogger(logger))
+
+		_ = store.Add(ctx, Schedule{ID: "broken", Spec: "not a spec", TaskType: "report", NextRun: now})
+
+		logger.On("Warn", `failed to claim schedule broken: invalid schedule spec: "not a spec": expected 5 fields, got 3`).Once()
+
+		// Act
+		scheduler.fireDue(ctx, now)
+
+		// Assert
+		logger.AssertExpectations(t)
+	})
+}
+
+func Test_Scheduler_Start(t *testing.T) {
+	t.Run("Fires due schedules until the context is cancelled", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		store := NewInMemoryStore()
+		taskQueue := broker.NewChannelBroker[task.Task](1)
+		scheduler := NewScheduler(store, taskQueue, WithPollInterval(time.Millisecond))
+
+		_ = store.Add(ctx, Schedule{ID: "due", Spec: "@hourly", TaskType: "report", NextRun: time.Now().Add(-time.Second)})
+
+		// Act
+		scheduler.Start(ctx)
+
+		submitted := <-taskQueue.Dequeue(ctx)
+
+		cancel()
+		scheduler.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "report", submitted.Type)
+	})
+}
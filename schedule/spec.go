@@ -0,0 +1,267 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidSpec is returned when a schedule expression cannot be parsed.
+var ErrInvalidSpec = errors.New("invalid schedule spec")
+
+// maxSearchYears bounds the search for the next run time of a cron expression, so
+// that expressions which can never match (e.g. "0 0 30 2 *") terminate.
+const maxSearchYears = 5
+
+// minInterval is the shortest interval accepted by "@every". Schedules are only
+// checked periodically, so shorter intervals could not be honoured anyway.
+const minInterval = time.Second
+
+// A Spec computes the run times of a recurring schedule.
+type Spec interface {
+	// Next returns the first run time strictly after the given time, or the zero
+	// time if the schedule never runs again.
+	Next(after time.Time) time.Time
+}
+
+// descriptors maps the supported "@" shorthands onto their cron expressions.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var dayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// Parse parses a schedule expression. Two forms are accepted:
+//
+//   - A standard five field cron expression ("minute hour day-of-month month
+//     day-of-week"), supporting "*", ranges ("1-5"), lists ("1,15"), steps ("*/10")
+//     and three letter month and day names. The "@hourly", "@daily", "@midnight",
+//     "@weekly", "@monthly", "@yearly" and "@annually" shorthands are also accepted.
+//     Cron expressions are evaluated in UTC.
+//   - "@every <duration>", e.g. "@every 5m", which runs at a fixed interval.
+func Parse(expr string) (Spec, error) {
+	expr = strings.TrimSpace(expr)
+
+	if interval, ok := strings.CutPrefix(expr, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(interval))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrInvalidSpec, expr, err)
+		}
+
+		if d < minInterval {
+			return nil, fmt.Errorf("%w: %q: interval must be at least %s", ErrInvalidSpec, expr, minInterval)
+		}
+
+		return intervalSpec{interval: d}, nil
+	}
+
+	if cronExpr, ok := descriptors[expr]; ok {
+		expr = cronExpr
+	}
+
+	spec, err := parseCron(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", ErrInvalidSpec, expr, err)
+	}
+
+	return spec, nil
+}
+
+type intervalSpec struct {
+	interval time.Duration
+}
+
+func (i intervalSpec) Next(after time.Time) time.Time {
+	return after.Add(i.interval)
+}
+
+// cronSpec holds the allowed values of each cron field as a bitset, where bit n is
+// set if value n matches.
+type cronSpec struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+
+	// As in standard cron, if both day fields are restricted (i.e. not "*") a day
+	// matches if either field matches, otherwise both must match.
+	domRestricted bool
+	dowRestricted bool
+}
+
+func parseCron(expr string) (cronSpec, error) {
+	fields := strings.Fields(expr)
+
+	numFields := 5
+	if len(fields) != numFields {
+		return cronSpec{}, fmt.Errorf("expected %d fields, got %d", numFields, len(fields))
+	}
+
+	var (
+		spec cronSpec
+		err  error
+	)
+
+	if spec.minute, err = parseField(fields[0], 0, 59, nil); err != nil { // nolint:mnd // minutes in an hour
+		return cronSpec{}, fmt.Errorf("minute: %w", err)
+	}
+
+	if spec.hour, err = parseField(fields[1], 0, 23, nil); err != nil { // nolint:mnd // hours in a day
+		return cronSpec{}, fmt.Errorf("hour: %w", err)
+	}
+
+	if spec.dom, err = parseField(fields[2], 1, 31, nil); err != nil { // nolint:mnd // days in a month
+		return cronSpec{}, fmt.Errorf("day of month: %w", err)
+	}
+
+	if spec.month, err = parseField(fields[3], 1, 12, monthNames); err != nil { // nolint:mnd // months in a year
+		return cronSpec{}, fmt.Errorf("month: %w", err)
+	}
+
+	// Day of week accepts 7 as well as 0 for Sunday
+	if spec.dow, err = parseField(fields[4], 0, 7, dayNames); err != nil { // nolint:mnd // days in a week
+		return cronSpec{}, fmt.Errorf("day of week: %w", err)
+	}
+
+	if spec.dow&(1<<7) != 0 {
+		spec.dow = spec.dow&^(1<<7) | 1
+	}
+
+	spec.domRestricted = !strings.HasPrefix(fields[2], "*")
+	spec.dowRestricted = !strings.HasPrefix(fields[4], "*")
+
+	return spec, nil
+}
+
+func parseField(field string, minVal, maxVal int, names map[string]int) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+
+		if hasStep {
+			var err error
+
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepPart)
+			}
+		}
+
+		var lo, hi int
+
+		switch {
+		case rangePart == "*":
+			lo, hi = minVal, maxVal
+
+		case strings.Contains(rangePart, "-"):
+			loPart, hiPart, _ := strings.Cut(rangePart, "-")
+
+			var err error
+
+			if lo, err = parseValue(loPart, names); err != nil {
+				return 0, err
+			}
+
+			if hi, err = parseValue(hiPart, names); err != nil {
+				return 0, err
+			}
+
+		default:
+			var err error
+
+			if lo, err = parseValue(rangePart, names); err != nil {
+				return 0, err
+			}
+
+			// "5/15" means every 15 starting at 5
+			hi = lo
+			if hasStep {
+				hi = maxVal
+			}
+		}
+
+		if lo < minVal || hi > maxVal || lo > hi {
+			return 0, fmt.Errorf("%q is out of range %d-%d", rangePart, minVal, maxVal)
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+func parseValue(value string, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+
+	return v, nil
+}
+
+func (c cronSpec) Next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (c cronSpec) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+
+	if c.domRestricted && c.dowRestricted {
+		return domMatch || dowMatch
+	}
+
+	return domMatch && dowMatch
+}
@@ -0,0 +1,124 @@
+//go:build unit
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Parse(t *testing.T) {
+	// Monday 1st January 2024, 10:30:15 UTC
+	from := time.Date(2024, 1, 1, 10, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		spec     string
+		expected time.Time
+	}{
+		{
+			name:     "Every minute",
+			spec:     "* * * * *",
+			expected: time.Date(2024, 1, 1, 10, 31, 0, 0, time.UTC),
+		},
+		{
+			name:     "Minute step",
+			spec:     "*/20 * * * *",
+			expected: time.Date(2024, 1, 1, 10, 40, 0, 0, time.UTC),
+		},
+		{
+			name:     "Fixed time later today",
+			spec:     "0 14 * * *",
+			expected: time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Fixed time already passed today",
+			spec:     "0 2 * * *",
+			expected: time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Hour list and range",
+			spec:     "15 1,3-5 * * *",
+			expected: time.Date(2024, 1, 2, 1, 15, 0, 0, time.UTC),
+		},
+		{
+			name:     "Day of week name",
+			spec:     "0 9 * * fri",
+			expected: time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Sunday as 7",
+			spec:     "0 0 * * 7",
+			expected: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Month name wraps into the next year",
+			spec:     "0 0 1 jan *",
+			expected: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Either restricted day field matches",
+			spec:     "0 0 15 * wed",
+			expected: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Leap day",
+			spec:     "0 0 29 2 *",
+			expected: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Descriptor",
+			spec:     "@monthly",
+			expected: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Interval",
+			spec:     "@every 90s",
+			expected: time.Date(2024, 1, 1, 10, 31, 45, 0, time.UTC),
+		},
+		{
+			name:     "Never matches",
+			spec:     "0 0 30 2 *",
+			expected: time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			spec, err := Parse(tt.spec)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, spec.Next(from))
+		})
+	}
+
+	invalid := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"* * * foo *",
+		"@every",
+		"@every soon",
+		"@every 10ms",
+	}
+
+	for _, spec := range invalid {
+		t.Run("Returns an error for "+spec, func(t *testing.T) {
+			// Act
+			_, err := Parse(spec)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidSpec)
+		})
+	}
+}
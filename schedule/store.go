@@ -0,0 +1,102 @@
+package schedule
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// A Schedule is a recurring task. Each time the schedule fires, a task of TaskType
+// with the given Payload is submitted to the task queue.
+type Schedule struct {
+	ID       string
+	Spec     string
+	TaskType string
+	Payload  any
+	NextRun  time.Time
+}
+
+// Store holds the registered schedules. Several schedulers may share a store, so
+// Advance must be atomic: it is how a scheduler claims the right to fire a run.
+type Store interface {
+	// Add stores the schedule, replacing any schedule with the same ID.
+	Add(ctx context.Context, s Schedule) error
+
+	// Remove deletes the schedule with the given ID, returning whether it existed.
+	Remove(ctx context.Context, id string) (bool, error)
+
+	// List returns every stored schedule, ordered by ID.
+	List(ctx context.Context) ([]Schedule, error)
+
+	// Advance moves the next run of the schedule from "from" to "to", returning
+	// true only if the schedule exists and its next run was still "from".
+	Advance(ctx context.Context, id string, from, to time.Time) (bool, error)
+}
+
+// InMemoryStore is a Store for a single process.
+type InMemoryStore struct {
+	schedules map[string]Schedule
+	mu        sync.Mutex
+}
+
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		schedules: make(map[string]Schedule),
+	}
+}
+
+func (s *InMemoryStore) Add(_ context.Context, schedule Schedule) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.schedules[schedule.ID] = schedule
+
+	return nil
+}
+
+func (s *InMemoryStore) Remove(_ context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.schedules[id]
+	delete(s.schedules, id)
+
+	return ok, nil
+}
+
+func (s *InMemoryStore) List(_ context.Context) ([]Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedules := make([]Schedule, 0, len(s.schedules))
+
+	for _, schedule := range s.schedules {
+		schedules = append(schedules, schedule)
+	}
+
+	sortByID(schedules)
+
+	return schedules, nil
+}
+
+func (s *InMemoryStore) Advance(_ context.Context, id string, from, to time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, ok := s.schedules[id]
+	if !ok || !schedule.NextRun.Equal(from) {
+		return false, nil
+	}
+
+	schedule.NextRun = to
+	s.schedules[id] = schedule
+
+	return true, nil
+}
+
+func sortByID(schedules []Schedule) {
+	sort.Slice(schedules, func(i, j int) bool {
+		return schedules[i].ID < schedules[j].ID
+	})
+}
@@ -0,0 +1,61 @@
+package goflow
+
+import (
+	"sync"
+
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+// subscriptions tracks the callers waiting on the results of tasks, so that results
+// can be handed to them as soon as they are persisted.
+type subscriptions struct {
+	mu     sync.Mutex
+	byTask map[string]map[chan task.Result]struct{}
+}
+
+// add registers notify to receive the results of the given tasks.
+func (s *subscriptions) add(taskIDs []string, notify chan task.Result) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.byTask == nil {
+		s.byTask = make(map[string]map[chan task.Result]struct{})
+	}
+
+	for _, id := range taskIDs {
+		if s.byTask[id] == nil {
+			s.byTask[id] = make(map[chan task.Result]struct{})
+		}
+
+		s.byTask[id][notify] = struct{}{}
+	}
+}
+
+// remove stops notify receiving the results of the given tasks.
+func (s *subscriptions) remove(taskIDs []string, notify chan task.Result) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range taskIDs {
+		delete(s.byTask[id], notify)
+
+		if len(s.byTask[id]) == 0 {
+			delete(s.byTask, id)
+		}
+	}
+}
+
+// publish hands the result to everyone subscribed to its task. It never blocks: a
+// subscriber that is not keeping up misses the notification and picks the result
+// up from the results store instead.
+func (s *subscriptions) publish(result task.Result) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for notify := range s.byTask[result.TaskID] {
+		select {
+		case notify <- result:
+		default:
+		}
+	}
+}
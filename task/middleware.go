@@ -0,0 +1,35 @@
+package task
+
+import "context"
+
+// Middleware wraps a Handler to add behaviour around it, such as logging or
+// validation, without changing the handler itself.
+type Middleware func(Handler) Handler
+
+// Chain wraps h with the middleware. The first middleware is the outermost, so it
+// sees the task first and the result last.
+func Chain(h Handler, middleware ...Middleware) Handler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		h = middleware[i](h)
+	}
+
+	return h
+}
+
+type panicReporterKey struct{}
+
+// WithPanicReporter returns a copy of ctx that carries report, which ReportPanic
+// calls. The worker pool sets it on each task's context so that it can count panics
+// recovered by middleware.
+func WithPanicReporter(ctx context.Context, report func(recovered any, stack []byte)) context.Context {
+	return context.WithValue(ctx, panicReporterKey{}, report)
+}
+
+// ReportPanic reports a panic recovered from a handler to whatever is running it, if
+// anything is listening. Middleware that recovers panics should call it, so that the
+// worker pool still counts them towards quarantining the task type.
+func ReportPanic(ctx context.Context, recovered any, stack []byte) {
+	if report, ok := ctx.Value(panicReporterKey{}).(func(any, []byte)); ok {
+		report(recovered, stack)
+	}
+}
@@ -0,0 +1,53 @@
+//go:build unit
+
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Chain(t *testing.T) {
+	t.Run("Applies the middleware in order with the first outermost", func(t *testing.T) {
+		// Arrange
+		var calls []string
+
+		record := func(name string) Middleware {
+			return func(next Handler) Handler {
+				return func(ctx context.Context, t Task) Result {
+					calls = append(calls, name+" before")
+					result := next(ctx, t)
+					calls = append(calls, name+" after")
+
+					return result
+				}
+			}
+		}
+
+		handler := func(_ context.Context, _ Task) Result {
+			calls = append(calls, "handler")
+
+			return Result{Payload: "done"}
+		}
+
+		// Act
+		result := Chain(handler, record("first"), record("second"))(context.Background(), Task{})
+
+		// Assert
+		assert.Equal(t, Result{Payload: "done"}, result)
+		assert.Equal(t, []string{"first before", "second before", "handler", "second after", "first after"}, calls)
+	})
+
+	t.Run("Returns the handler unchanged without middleware", func(t *testing.T) {
+		// Arrange
+		handler := func(_ context.Context, t Task) Result { return Result{Payload: t.Payload} }
+
+		// Act
+		result := Chain(handler)(context.Background(), Task{Payload: "payload"})
+
+		// Assert
+		assert.Equal(t, Result{Payload: "payload"}, result)
+	})
+}
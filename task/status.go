@@ -0,0 +1,80 @@
+package task
+
+import "time"
+
+// State is the stage of its lifecycle that a task has reached.
+type State string
+
+const (
+	// StatePending tasks are waiting on the task queue, either to run for the first
+	// time or to be retried.
+	StatePending State = "pending"
+
+	// StateRunning tasks have been picked up by a worker.
+	StateRunning State = "running"
+
+	// StateSucceeded tasks have finished without an error.
+	StateSucceeded State = "succeeded"
+
+	// StateFailed tasks have finished with an error, after any retries.
+	StateFailed State = "failed"
+
+	// StateCancelled tasks were cancelled before they finished.
+	StateCancelled State = "cancelled"
+)
+
+// CancelledErrMsg is the error message of the result written for a cancelled task.
+const CancelledErrMsg = "task was cancelled"
+
+// Terminal reports whether a task in this state has finished and will not change
+// state again.
+func (s State) Terminal() bool {
+	return s == StateSucceeded || s == StateFailed || s == StateCancelled
+}
+
+// Status describes where a task is in its lifecycle. Timestamps are zero until the
+// task has reached the corresponding stage.
+type Status struct {
+	TaskID   string
+	TaskType string
+	State    State
+
+	// Attempt is the attempt the task is waiting for, running or finished on.
+	Attempt int
+
+	EnqueuedAt time.Time
+	StartedAt  time.Time
+	FinishedAt time.Time
+
+	Metadata map[string]string
+}
+
+// Supersedes reports whether s is a later status of the task than prev. Status
+// updates can arrive out of order when there are several consumers of the results
+// queue, so they should only be applied if they supersede the current status.
+//
+// A cancellation is final, and only supersedes a task that has not finished yet.
+func (s Status) Supersedes(prev Status) bool {
+	if prev.State == StateCancelled {
+		return false
+	}
+
+	if s.State == StateCancelled {
+		return !prev.State.Terminal()
+	}
+
+	if s.State.Terminal() {
+		return true
+	}
+
+	if prev.State.Terminal() {
+		return false
+	}
+
+	if s.Attempt != prev.Attempt {
+		return s.Attempt > prev.Attempt
+	}
+
+	// A task runs after it is pending for the same attempt
+	return s.State == StateRunning || prev.State != StateRunning
+}
@@ -0,0 +1,75 @@
+//go:build unit
+
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Result_Status(t *testing.T) {
+	t.Run("Copies the state and timestamps from the result", func(t *testing.T) {
+		// Arrange
+		now := time.Now()
+		result := Result{
+			TaskID:     "task-id",
+			State:      StateRunning,
+			Attempt:    2,
+			EnqueuedAt: now.Add(-time.Minute),
+			StartedAt:  now,
+		}
+
+		// Act
+		status := result.Status()
+
+		// Assert
+		assert.Equal(t, Status{
+			TaskID:     "task-id",
+			State:      StateRunning,
+			Attempt:    2,
+			EnqueuedAt: now.Add(-time.Minute),
+			StartedAt:  now,
+		}, status)
+	})
+
+	t.Run("Treats results without a state as finished", func(t *testing.T) {
+		// Act
+		succeeded := Result{TaskID: "task-id"}.Status()
+		failed := Result{TaskID: "task-id", ErrMsg: "failed"}.Status()
+
+		// Assert
+		assert.Equal(t, StateSucceeded, succeeded.State)
+		assert.Equal(t, StateFailed, failed.State)
+	})
+}
+
+func Test_Status_Supersedes(t *testing.T) {
+	tests := []struct {
+		name     string
+		prev     Status
+		next     Status
+		expected bool
+	}{
+		{"Running supersedes pending", Status{State: StatePending, Attempt: 1}, Status{State: StateRunning, Attempt: 1}, true},
+		{"Pending does not supersede running", Status{State: StateRunning, Attempt: 1}, Status{State: StatePending, Attempt: 1}, false},
+		{"A retry supersedes the previous attempt", Status{State: StateRunning, Attempt: 1}, Status{State: StatePending, Attempt: 2}, true},
+		{"An earlier attempt does not supersede a later one", Status{State: StatePending, Attempt: 2}, Status{State: StateRunning, Attempt: 1}, false},
+		{"A finished status supersedes anything", Status{State: StateRunning, Attempt: 3}, Status{State: StateFailed}, true},
+		{"Nothing but a finished status supersedes a finished one", Status{State: StateSucceeded, Attempt: 1}, Status{State: StateRunning, Attempt: 2}, false},
+		{"A cancellation supersedes a running task", Status{State: StateRunning, Attempt: 1}, Status{State: StateCancelled, Attempt: 1}, true},
+		{"A cancellation does not supersede a finished task", Status{State: StateSucceeded, Attempt: 1}, Status{State: StateCancelled, Attempt: 1}, false},
+		{"Nothing supersedes a cancellation", Status{State: StateCancelled, Attempt: 1}, Status{State: StateFailed, Attempt: 1}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			supersedes := tt.next.Supersedes(tt.prev)
+
+			// Assert
+			assert.Equal(t, tt.expected, supersedes)
+		})
+	}
+}
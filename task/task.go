@@ -2,32 +2,119 @@ package task
 
 import (
 	"context"
+	"encoding/gob"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-// Handler processes task payloads
-type Handler func(payload any) Result
+func init() {
+	// Payloads built by GoFlow itself must decode in every process that links this
+	// package, including the worker pool, which does not link the packages that
+	// build them. Workflow steps with several dependencies receive a map, and group
+	// reducers receive the results of the group's members.
+	gob.Register(map[string]any{})
+	gob.Register([]Result{})
+}
+
+// Handler processes tasks. The context is derived from the worker pool's context,
+// so it is cancelled when GoFlow shuts down. Long-running handlers should watch
+// ctx.Done() and return early when it is closed.
+type Handler func(ctx context.Context, t Task) Result
+
+// PayloadHandler is the original, context-free handler signature. It only receives
+// the task payload. Use FromPayloadHandler to register a PayloadHandler wherever a
+// Handler is expected.
+type PayloadHandler func(payload any) Result
+
+// FromPayloadHandler adapts a PayloadHandler to the Handler signature. The context
+// is ignored, so the wrapped handler will run to completion even after cancellation.
+func FromPayloadHandler(h PayloadHandler) Handler {
+	return func(_ context.Context, t Task) Result {
+		return h(t.Payload)
+	}
+}
 
 // Type represents a generic task structure
 type Task struct {
 	ID      string
 	Type    string
 	Payload any
+
+	// Attempt is the number of times the task has been handed to a handler. The
+	// worker pool increments it before every attempt, so it is 1 on the first run.
+	Attempt int
+
+	// Timeout is the maximum time a single attempt may run for. The worker pool
+	// cancels the handler's context and writes a timeout result once it passes.
+	// Zero means no timeout.
+	Timeout time.Duration
+
+	// Priority ranges from 0, the default, to MaxPriority. Brokers dequeue tasks
+	// with a higher priority first.
+	Priority int
+
+	// EnqueuedAt is when the task was created to be pushed.
+	EnqueuedAt time.Time
+
+	// Metadata holds caller-defined values that travel with the task, such as a
+	// trace ID or tenant. The worker pool copies it onto every result for the task.
+	Metadata map[string]string
 }
 
+// MaxPriority is the highest priority a task can have.
+const MaxPriority = 9
+
+// Result is the outcome of a task. Workers also send results to report that a task
+// has changed state without finishing, in which case State is not terminal and
+// there is no payload or error.
 type Result struct {
-	TaskID  string
-	Payload any
-	ErrMsg  string
+	TaskID   string
+	TaskType string
+	Payload  any
+	ErrMsg   string
+
+	State      State
+	Attempt    int
+	EnqueuedAt time.Time
+	StartedAt  time.Time
+	FinishedAt time.Time
+
+	// Metadata is the metadata of the task, along with any set by its handler.
+	Metadata map[string]string
+}
+
+// Status returns the status of the task as reported by the result. Results without
+// a state, such as those from older workers, are treated as finished.
+func (r Result) Status() Status {
+	state := r.State
+
+	if state == "" {
+		state = StateSucceeded
+		if r.ErrMsg != "" {
+			state = StateFailed
+		}
+	}
+
+	return Status{
+		TaskID:     r.TaskID,
+		TaskType:   r.TaskType,
+		State:      state,
+		Attempt:    r.Attempt,
+		EnqueuedAt: r.EnqueuedAt,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+		Metadata:   r.Metadata,
+	}
 }
 
 func New(taskType string, payload any) Task {
 	id := uuid.New()
 	t := Task{
-		ID:      id.String(),
-		Type:    taskType,
-		Payload: payload,
+		ID:         id.String(),
+		Type:       taskType,
+		Payload:    payload,
+		EnqueuedAt: time.Now(),
 	}
 
 	return t
@@ -45,3 +132,28 @@ type Submitter[T TaskOrResult] interface {
 type Dequeuer[T TaskOrResult] interface {
 	Dequeue(ctx context.Context) <-chan T
 }
+
+// BatchSubmitter is implemented by brokers that can submit many submissions in a
+// single round trip. Submissions at the same priority are queued in the order given.
+// A batch must be submitted atomically: if SubmitBatch returns an error, none of the
+// batch was submitted.
+type BatchSubmitter[T TaskOrResult] interface {
+	SubmitBatch(ctx context.Context, batch []T) error
+}
+
+// Canceller is implemented by brokers that can cancel tasks. Cancellations are
+// recorded by task ID, and workers check for them before running a task and while
+// it runs, so a cancellation reaches every consumer of the broker.
+type Canceller interface {
+	// Cancel records that the task with the given ID has been cancelled.
+	Cancel(ctx context.Context, taskID string) error
+
+	// Cancelled reports whether the task with the given ID has been cancelled.
+	Cancelled(ctx context.Context, taskID string) (bool, error)
+}
+
+// DelayedSubmitter is implemented by brokers that can hold a submission back until
+// a given time. Submissions scheduled in the past are delivered as soon as possible.
+type DelayedSubmitter[T TaskOrResult] interface {
+	SubmitAt(ctx context.Context, t T, at time.Time) error
+}
@@ -0,0 +1,46 @@
+//go:build unit
+
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_New(t *testing.T) {
+	t.Run("Creates a task with a unique ID", func(t *testing.T) {
+		// Act
+		first := New("type", "payload")
+		second := New("type", "payload")
+
+		// Assert
+		assert.NotEmpty(t, first.ID)
+		assert.NotEqual(t, first.ID, second.ID)
+		assert.Equal(t, "type", first.Type)
+		assert.Equal(t, "payload", first.Payload)
+		assert.WithinDuration(t, time.Now(), first.EnqueuedAt, time.Second)
+	})
+}
+
+func Test_FromPayloadHandler(t *testing.T) {
+	t.Run("Calls the payload handler with the task payload", func(t *testing.T) {
+		// Arrange
+		var received any
+
+		legacy := func(payload any) Result {
+			received = payload
+
+			return Result{Payload: "done"}
+		}
+
+		// Act
+		result := FromPayloadHandler(legacy)(context.Background(), Task{Payload: "payload"})
+
+		// Assert
+		assert.Equal(t, "payload", received)
+		assert.Equal(t, Result{Payload: "done"}, result)
+	})
+}
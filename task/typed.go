@@ -0,0 +1,84 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// TypedHandler processes tasks whose payload decodes to In. Its output is encoded as
+// the result payload, and a non-nil error becomes the result's ErrMsg.
+type TypedHandler[In, Out any] func(ctx context.Context, in In) (Out, error)
+
+// Typed adapts a TypedHandler to the Handler signature. The payload is decoded with
+// DecodePayload and the output is encoded with EncodePayload. A payload that cannot
+// be decoded fails the task without calling the handler.
+func Typed[In, Out any](h TypedHandler[In, Out]) Handler {
+	return func(ctx context.Context, t Task) Result {
+		in, err := DecodePayload[In](t.Payload)
+		if err != nil {
+			return Result{ErrMsg: fmt.Sprintf("invalid payload: %v", err)}
+		}
+
+		out, err := h(ctx, in)
+		if err != nil {
+			return Result{ErrMsg: err.Error()}
+		}
+
+		payload, err := EncodePayload(out)
+		if err != nil {
+			return Result{ErrMsg: fmt.Sprintf("invalid output: %v", err)}
+		}
+
+		return Result{Payload: payload}
+	}
+}
+
+// EncodePayload encodes v as a JSON string. Strings survive every serialiser GoFlow
+// uses, including the gob serialiser in distributed mode, and are what the CLI
+// pushes, so typed payloads can be pushed from anywhere.
+func EncodePayload(v any) (string, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+// DecodePayload decodes a task or result payload to T. Strings and byte slices are
+// decoded as JSON. If that fails, or the payload is of another type, a payload that
+// is already a T is returned as it is, so a plain string can still be decoded to a
+// string.
+func DecodePayload[T any](payload any) (T, error) {
+	var decoded T
+
+	var encoded []byte
+
+	switch p := payload.(type) {
+	case string:
+		encoded = []byte(p)
+	case []byte:
+		encoded = p
+	}
+
+	var err error
+
+	if encoded != nil {
+		if err = json.Unmarshal(encoded, &decoded); err == nil {
+			return decoded, nil
+		}
+	}
+
+	if value, ok := payload.(T); ok {
+		return value, nil
+	}
+
+	var zero T
+
+	if err != nil {
+		return zero, err
+	}
+
+	return zero, fmt.Errorf("cannot decode payload of type %T to %T", payload, zero)
+}
@@ -0,0 +1,91 @@
+//go:build unit
+
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type doubleInput struct {
+	N int
+}
+
+type doubleOutput struct {
+	Doubled int
+}
+
+func Test_Typed(t *testing.T) {
+	double := func(_ context.Context, in doubleInput) (doubleOutput, error) {
+		if in.N < 0 {
+			return doubleOutput{}, errors.New("negative input")
+		}
+
+		return doubleOutput{Doubled: in.N * 2}, nil
+	}
+
+	tests := []struct {
+		name    string
+		payload any
+		want    Result
+	}{
+		{"Decodes a JSON payload and encodes the output", `{"N": 2}`, Result{Payload: `{"Doubled":4}`}},
+		{"Accepts a payload that is already the input type", doubleInput{N: 3}, Result{Payload: `{"Doubled":6}`}},
+		{"Maps a handler error to the result", `{"N": -1}`, Result{ErrMsg: "negative input"}},
+		{"Fails a payload that cannot be decoded", 4, Result{ErrMsg: "invalid payload: cannot decode payload of type int to task.doubleInput"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			result := Typed(double)(context.Background(), Task{Payload: tt.payload})
+
+			// Assert
+			assert.Equal(t, tt.want, result)
+		})
+	}
+
+	t.Run("Fails malformed JSON", func(t *testing.T) {
+		// Act
+		result := Typed(double)(context.Background(), Task{Payload: `{"N": `})
+
+		// Assert
+		assert.Contains(t, result.ErrMsg, "invalid payload")
+	})
+}
+
+func Test_DecodePayload(t *testing.T) {
+	t.Run("Round trips an encoded string", func(t *testing.T) {
+		// Arrange
+		encoded, err := EncodePayload("hello")
+		assert.NoError(t, err)
+
+		// Act
+		decoded, err := DecodePayload[string](encoded)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", decoded)
+	})
+
+	t.Run("Returns a plain string that is not JSON as it is", func(t *testing.T) {
+		// Act
+		decoded, err := DecodePayload[string]("hello")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", decoded)
+	})
+
+	t.Run("Decodes a byte slice as JSON", func(t *testing.T) {
+		// Act
+		decoded, err := DecodePayload[doubleInput]([]byte(`{"N": 5}`))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, doubleInput{N: 5}, decoded)
+	})
+}
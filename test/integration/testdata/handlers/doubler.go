@@ -30,6 +30,6 @@ func handle(payload any) task.Result {
 	return task.Result{Payload: in.N * 2}
 }
 
-func NewHandler() task.Handler {
+func NewHandler() task.PayloadHandler {
 	return handle
 }
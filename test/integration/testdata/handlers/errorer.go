@@ -1,13 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/jamesTait-jt/goflow/task"
 )
 
-func handle(payload any) task.Result {
-	err := fmt.Errorf("error for payload: %v", payload)
+func handle(_ context.Context, t task.Task) task.Result {
+	err := fmt.Errorf("error for payload: %v", t.Payload)
 	return task.Result{ErrMsg: err.Error()}
 }
 
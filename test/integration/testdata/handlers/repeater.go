@@ -1,11 +1,13 @@
 package main
 
 import (
+	"context"
+
 	"github.com/jamesTait-jt/goflow/task"
 )
 
-func handle(payload any) task.Result {
-	return task.Result{Payload: payload}
+func handle(_ context.Context, t task.Task) task.Result {
+	return task.Result{Payload: t.Payload}
 }
 
 func NewHandler() task.Handler {
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+type input struct {
+	N int
+}
+
+type output struct {
+	Tripled int
+}
+
+func triple(_ context.Context, in input) (output, error) {
+	return output{Tripled: in.N * 3}, nil
+}
+
+func NewHandler() task.Handler {
+	return task.Typed(triple)
+}
@@ -95,6 +95,27 @@ func TestWorkerpool_Integration(t *testing.T) {
 		assert.Equal(t, repeatTask.ID, repeatResult.TaskID)
 	})
 
+	t.Run("Handles typed plugins", func(t *testing.T) {
+		// Arrange
+		tripleTask := task.New("tripler", `{"N":10}`)
+		serialisedTriple, err := taskSerialiser.Serialise(tripleTask)
+		require.NoError(t, err)
+
+		// Act
+		_, err = client.LPush(ctx, "tasks", serialisedTriple).Result()
+		require.NoError(t, err)
+
+		redisResult, err := client.BRPop(ctx, 5*time.Second, "results").Result()
+		require.NoError(t, err)
+
+		tripleResult, err := resultSerialiser.Deserialise([]byte(redisResult[1]))
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, `{"Tripled":30}`, tripleResult.Payload)
+		assert.Equal(t, tripleTask.ID, tripleResult.TaskID)
+	})
+
 	t.Run("Handles plugins that return errors", func(t *testing.T) {
 		// Arrange
 		errorMe := true
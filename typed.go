@@ -0,0 +1,50 @@
+package goflow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+// RegisterTyped registers a handler that receives its payload decoded to In and
+// returns an Out, instead of working with task.Task and task.Result directly (see
+// task.Typed). Like RegisterHandler, it only has an effect in local mode. In
+// distributed mode, plugins can return task.Typed(handler) from NewHandler.
+func RegisterTyped[In, Out any](gf *GoFlow, taskType string, handler func(context.Context, In) (Out, error)) {
+	gf.RegisterHandler(taskType, task.Typed(task.TypedHandler[In, Out](handler)))
+}
+
+// PushTyped pushes a task whose payload is in, encoded with task.EncodePayload so
+// that a typed handler can decode it in either mode.
+func PushTyped[In any](gf *GoFlow, taskType string, in In, opts ...PushOption) (string, error) {
+	payload, err := task.EncodePayload(in)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	return gf.Push(taskType, payload, opts...)
+}
+
+// GetTypedResult retrieves the result of a task run by a typed handler, decoding its
+// payload to Out. As with GetResult, the boolean is false if the task has not
+// finished or does not exist. If the task failed, the error wraps ErrTaskFailed.
+func GetTypedResult[Out any](gf *GoFlow, taskID string) (Out, bool, error) {
+	var out Out
+
+	result, ok, err := gf.GetResult(taskID)
+	if err != nil || !ok {
+		return out, ok, err
+	}
+
+	if result.ErrMsg != "" {
+		return out, true, fmt.Errorf("%w: %s", ErrTaskFailed, result.ErrMsg)
+	}
+
+	out, err = task.DecodePayload[Out](result.Payload)
+	if err != nil {
+		return out, true, fmt.Errorf("failed to decode result: %w", err)
+	}
+
+	return out, true, nil
+}
@@ -0,0 +1,97 @@
+//go:build unit
+
+package goflow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/pkg/store"
+	"github.com/jamesTait-jt/goflow/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type doubleInput struct {
+	N int
+}
+
+type doubleOutput struct {
+	Doubled int
+}
+
+func Test_GoFlow_Typed(t *testing.T) {
+	t.Run("Runs a typed handler on a typed push and decodes its result", func(t *testing.T) {
+		// Arrange
+		gf := NewLocalMode(store.NewInMemoryKVStore[string, task.Handler](), WithNumWorkers(1))
+
+		RegisterTyped(gf, "double", func(_ context.Context, in doubleInput) (doubleOutput, error) {
+			return doubleOutput{Doubled: in.N * 2}, nil
+		})
+
+		_ = gf.Start()
+		defer gf.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// Act
+		taskID, pushErr := PushTyped(gf, "double", doubleInput{N: 21})
+		_, awaitErr := gf.Await(ctx, taskID)
+		out, ok, err := GetTypedResult[doubleOutput](gf, taskID)
+
+		// Assert
+		assert.NoError(t, pushErr)
+		assert.NoError(t, awaitErr)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, doubleOutput{Doubled: 42}, out)
+	})
+
+	t.Run("Returns ErrTaskFailed with the handler's error", func(t *testing.T) {
+		// Arrange
+		gf := NewLocalMode(store.NewInMemoryKVStore[string, task.Handler](), WithNumWorkers(1))
+
+		RegisterTyped(gf, "double", func(_ context.Context, _ doubleInput) (doubleOutput, error) {
+			return doubleOutput{}, errors.New("cannot double")
+		})
+
+		_ = gf.Start()
+		defer gf.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// Act
+		taskID, _ := PushTyped(gf, "double", doubleInput{N: 21})
+		_, _ = gf.Await(ctx, taskID)
+		_, ok, err := GetTypedResult[doubleOutput](gf, taskID)
+
+		// Assert
+		assert.True(t, ok)
+		assert.ErrorIs(t, err, ErrTaskFailed)
+		assert.ErrorContains(t, err, "cannot double")
+	})
+
+	t.Run("Returns false if the result is not found", func(t *testing.T) {
+		// Arrange
+		mockResults := new(mockKVStore[string, task.Result])
+
+		gf := &GoFlow{
+			results: mockResults,
+			started: true,
+		}
+
+		mockResults.On("Get", mock.Anything).Once().Return(task.Result{}, false)
+
+		// Act
+		out, ok, err := GetTypedResult[doubleOutput](gf, "taskID")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, doubleOutput{}, out)
+	})
+}
@@ -0,0 +1,181 @@
+package workerpool
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/deadletter"
+	"github.com/jamesTait-jt/goflow/ratelimit"
+	"github.com/jamesTait-jt/goflow/retry"
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+var defaultCancelPollInterval = time.Second
+
+type poolOptions struct {
+	retryPolicies      map[string]retry.Policy
+	cancelPollInterval time.Duration
+	rateLimits         map[string]ratelimit.Limit
+	rateLimiter        ratelimit.Limiter
+	concurrencyLimits  map[string]int
+	middleware         []task.Middleware
+	panicThreshold     int
+	quarantinePeriod   time.Duration
+	deadLetters        deadletter.Queue
+}
+
+func defaultPoolOptions() poolOptions {
+	return poolOptions{
+		retryPolicies:      map[string]retry.Policy{},
+		cancelPollInterval: defaultCancelPollInterval,
+		rateLimits:         map[string]ratelimit.Limit{},
+		concurrencyLimits:  map[string]int{},
+	}
+}
+
+// A PoolOption sets options such as retry policies.
+type PoolOption interface {
+	apply(*poolOptions)
+}
+
+type retryPoliciesOption struct {
+	RetryPolicies map[string]retry.Policy
+}
+
+func (r retryPoliciesOption) apply(opts *poolOptions) {
+	for taskType, policy := range r.RetryPolicies {
+		opts.retryPolicies[taskType] = policy
+	}
+}
+
+// WithRetryPolicies sets the retry policy used for each task type. Failed tasks of a
+// type without a policy are not retried.
+func WithRetryPolicies(policies map[string]retry.Policy) PoolOption {
+	return retryPoliciesOption{RetryPolicies: policies}
+}
+
+type cancelPollIntervalOption struct {
+	CancelPollInterval time.Duration
+}
+
+func (c cancelPollIntervalOption) apply(opts *poolOptions) {
+	opts.cancelPollInterval = c.CancelPollInterval
+}
+
+// WithCancelPollInterval allows you to set how often workers check whether the task
+// they are running has been cancelled, if the task queue supports cancellation. A
+// running task is cancelled up to one interval after Cancel is called. Defaults to
+// one second.
+func WithCancelPollInterval(interval time.Duration) PoolOption {
+	return cancelPollIntervalOption{CancelPollInterval: interval}
+}
+
+type rateLimitsOption struct {
+	RateLimits map[string]ratelimit.Limit
+}
+
+func (r rateLimitsOption) apply(opts *poolOptions) {
+	for taskType, limit := range r.RateLimits {
+		opts.rateLimits[taskType] = limit
+	}
+}
+
+// WithRateLimits sets the rate limit for each task type. A task picked up while its
+// type is over the limit is held by the pool rather than failed, and the held tasks
+// of a type are run in the order they were picked up as tokens become available.
+// Tasks still held when the pool shuts down are put back on the task queue. Task
+// types without a limit are not rate limited. Panics if any limit's rate is not
+// positive.
+func WithRateLimits(limits map[string]ratelimit.Limit) PoolOption {
+	for taskType, limit := range limits {
+		if err := limit.Validate(); err != nil {
+			panic(fmt.Sprintf("workerpool: invalid rate limit for task type %q: %v", taskType, err))
+		}
+	}
+
+	return rateLimitsOption{RateLimits: limits}
+}
+
+type rateLimiterOption struct {
+	RateLimiter ratelimit.Limiter
+}
+
+func (r rateLimiterOption) apply(opts *poolOptions) {
+	opts.rateLimiter = r.RateLimiter
+}
+
+// WithRateLimiter allows you to set the limiter that holds the token buckets for the
+// rate limits. Pools that share a limiter, such as a ratelimit.RedisLimiter, share
+// their limits. Defaults to an in-memory limiter for the pool.
+func WithRateLimiter(limiter ratelimit.Limiter) PoolOption {
+	return rateLimiterOption{RateLimiter: limiter}
+}
+
+type concurrencyLimitsOption struct {
+	ConcurrencyLimits map[string]int
+}
+
+func (c concurrencyLimitsOption) apply(opts *poolOptions) {
+	for taskType, limit := range c.ConcurrencyLimits {
+		opts.concurrencyLimits[taskType] = limit
+	}
+}
+
+// WithConcurrencyLimits sets the maximum number of tasks of each type that the pool
+// runs at once, so that a slow task type cannot occupy every worker. A task picked
+// up while its type is at the limit is held by the pool, and the held tasks of a
+// type are run in the order they were picked up as slots are freed. Tasks still
+// held when the pool shuts down are put back on the task queue. Limits apply to
+// each pool separately. Task types without a limit may use every worker.
+func WithConcurrencyLimits(limits map[string]int) PoolOption {
+	return concurrencyLimitsOption{ConcurrencyLimits: limits}
+}
+
+type middlewareOption struct {
+	Middleware []task.Middleware
+}
+
+func (m middlewareOption) apply(opts *poolOptions) {
+	opts.middleware = append(opts.middleware, m.Middleware...)
+}
+
+// WithMiddleware wraps every handler the pool runs with the middleware, including
+// handlers registered after the pool is started. The first middleware is the
+// outermost (see task.Chain). Can be passed multiple times, in which case the
+// middleware is appended.
+func WithMiddleware(middleware ...task.Middleware) PoolOption {
+	return middlewareOption{Middleware: middleware}
+}
+
+type panicQuarantineOption struct {
+	Threshold int
+	Period    time.Duration
+}
+
+func (p panicQuarantineOption) apply(opts *poolOptions) {
+	opts.panicThreshold = p.Threshold
+	opts.quarantinePeriod = p.Period
+}
+
+// WithPanicQuarantine quarantines a task type once its handler has panicked
+// threshold times. Tasks of a quarantined type fail without being run until the
+// period has passed, after which its panics are counted from zero again. By default
+// task types are never quarantined.
+func WithPanicQuarantine(threshold int, period time.Duration) PoolOption {
+	return panicQuarantineOption{Threshold: threshold, Period: period}
+}
+
+type deadLetterQueueOption struct {
+	DeadLetterQueue deadletter.Queue
+}
+
+func (d deadLetterQueueOption) apply(opts *poolOptions) {
+	opts.deadLetters = d.DeadLetterQueue
+}
+
+// WithDeadLetterQueue records tasks that cannot be completed in the queue: tasks
+// with no registered handler, and tasks that failed on their final attempt. Without
+// a queue, tasks with no handler are dropped.
+func WithDeadLetterQueue(queue deadletter.Queue) PoolOption {
+	return deadLetterQueueOption{DeadLetterQueue: queue}
+}
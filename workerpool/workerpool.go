@@ -2,25 +2,111 @@ package workerpool
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"runtime/debug"
 	"sync"
+	"time"
 
+	"github.com/jamesTait-jt/goflow/deadletter"
+	"github.com/jamesTait-jt/goflow/ratelimit"
 	"github.com/jamesTait-jt/goflow/task"
 	"github.com/sirupsen/logrus"
 )
 
+// errTaskCancelled is the cause of the task context being cancelled when the task
+// is cancelled while it runs.
+var errTaskCancelled = errors.New("task was cancelled")
+
+// requeueHeldTimeout bounds how long a shutting down pool spends putting the tasks
+// it is holding back on the task queue.
+var requeueHeldTimeout = 5 * time.Second
+
 type HandlerGetter interface {
 	Get(taskType string) (task.Handler, bool)
 }
 
+// TaskQueue is the queue that workers consume tasks from. Workers also submit to it
+// when a failed task is scheduled for another attempt.
+type TaskQueue interface {
+	task.Submitter[task.Task]
+	task.Dequeuer[task.Task]
+}
+
 type Pool struct {
 	numWorkers int
 	wg         *sync.WaitGroup
+	opts       poolOptions
+
+	// running counts the tasks of each concurrency limited type being run, and
+	// blocked holds the tasks of each type waiting for a slot, in the order they
+	// were picked up
+	running   map[string]int
+	blocked   map[string][]heldTask
+	runningMu sync.Mutex
+
+	// slotFreed wakes the goroutine releasing the blocked tasks of each concurrency
+	// limited type when one of its slots is freed
+	slotFreed map[string]chan struct{}
+
+	panics   map[string]*panicRecord
+	panicsMu sync.Mutex
+
+	// rateHeld holds the tasks of each rate limited type that are waiting for a
+	// token, in the order they were picked up
+	rateHeld   map[string][]task.Task
+	rateHeldMu sync.Mutex
+
+	// released hands tasks held back by the pool to the workers once they can run
+	released chan heldTask
+}
+
+// heldTask is a task released to the workers after being held back by the pool.
+type heldTask struct {
+	task.Task
+
+	// tokenTaken is set if the task's rate limit token has already been taken
+	tokenTaken bool
+
+	// slotHeld is set if the task's concurrency slot has already been reserved
+	slotHeld bool
+}
+
+// panicRecord tracks the panics of a task type's handler.
+type panicRecord struct {
+	total            int
+	sinceQuarantine  int
+	quarantinedUntil time.Time
 }
 
-func New(numWorkers int) *Pool {
+func New(numWorkers int, opt ...PoolOption) *Pool {
+	opts := defaultPoolOptions()
+
+	for _, o := range opt {
+		o.apply(&opts)
+	}
+
+	if opts.rateLimiter == nil {
+		opts.rateLimiter = ratelimit.NewInMemoryLimiter()
+	}
+
 	wp := &Pool{
 		numWorkers: numWorkers,
 		wg:         &sync.WaitGroup{},
+		opts:       opts,
+		running:    map[string]int{},
+		blocked:    map[string][]heldTask{},
+		slotFreed:  map[string]chan struct{}{},
+		panics:     map[string]*panicRecord{},
+		rateHeld:   map[string][]task.Task{},
+		released:   make(chan heldTask),
+	}
+
+	for taskType := range opts.concurrencyLimits {
+		// Buffered so that freeing a slot never blocks, and is not missed by a
+		// goroutine that is not waiting yet
+		wp.slotFreed[taskType] = make(chan struct{}, 1)
 	}
 
 	return wp
@@ -28,14 +114,14 @@ func New(numWorkers int) *Pool {
 
 func (wp *Pool) Start(
 	ctx context.Context,
-	taskQueue task.Dequeuer[task.Task],
+	taskQueue TaskQueue,
 	results task.Submitter[task.Result],
 	taskHandlers HandlerGetter,
 ) {
 	for i := 0; i < wp.numWorkers; i++ {
 		wp.wg.Add(1)
 
-		go worker(ctx, wp.wg, taskQueue, results, taskHandlers)
+		go wp.worker(ctx, taskQueue, results, taskHandlers)
 	}
 }
 
@@ -43,14 +129,26 @@ func (wp *Pool) AwaitShutdown() {
 	wp.wg.Wait()
 }
 
-func worker(
+// Panics returns the number of times the handler for each task type has panicked.
+func (wp *Pool) Panics() map[string]int {
+	wp.panicsMu.Lock()
+	defer wp.panicsMu.Unlock()
+
+	panics := make(map[string]int, len(wp.panics))
+	for taskType, record := range wp.panics {
+		panics[taskType] = record.total
+	}
+
+	return panics
+}
+
+func (wp *Pool) worker(
 	ctx context.Context,
-	wg *sync.WaitGroup,
-	taskQueue task.Dequeuer[task.Task],
+	taskQueue TaskQueue,
 	results task.Submitter[task.Result],
 	taskHandlers HandlerGetter,
 ) {
-	defer wg.Done()
+	defer wp.wg.Done()
 
 	for {
 		select {
@@ -63,33 +161,787 @@ func worker(
 				"task_id": t.ID,
 			}).Info("Picked up task")
 
-			handler, ok := taskHandlers.Get(t.Type)
-			if !ok {
-				logrus.WithFields(logrus.Fields{
-					"task_type": t.Type,
-				}).Error("No handler registered for task type")
+			wp.process(ctx, taskQueue, results, taskHandlers, heldTask{Task: t})
+
+		case t := <-wp.released:
+			wp.process(ctx, taskQueue, results, taskHandlers, t)
+		}
+	}
+}
+
+// process runs a task picked up by a worker, unless it is held back by the pool's
+// limits.
+func (wp *Pool) process(
+	ctx context.Context,
+	taskQueue TaskQueue,
+	results task.Submitter[task.Result],
+	taskHandlers HandlerGetter,
+	held heldTask,
+) {
+	t := held.Task
+
+	// The result of a task cancelled while queued is written when it is
+	// cancelled
+	if cancelled(ctx, taskQueue, t.ID) {
+		logrus.WithFields(logrus.Fields{
+			"task_id": t.ID,
+		}).Info("Skipping cancelled task")
+
+		return
+	}
+
+	handler, ok := taskHandlers.Get(t.Type)
+	if !ok {
+		logrus.WithFields(logrus.Fields{
+			"task_type": t.Type,
+		}).Error("No handler registered for task type")
+
+		errMsg := fmt.Sprintf("no handler registered for task type %q", t.Type)
+
+		wp.deadLetter(ctx, t, errMsg)
+		wp.submitResult(ctx, results, task.Result{
+			TaskID:     t.ID,
+			TaskType:   t.Type,
+			ErrMsg:     errMsg,
+			State:      task.StateFailed,
+			Attempt:    t.Attempt,
+			EnqueuedAt: t.EnqueuedAt,
+			FinishedAt: time.Now(),
+			Metadata:   t.Metadata,
+		})
+
+		return
+	}
+
+	if until, ok := wp.quarantinedUntil(t.Type); ok {
+		logrus.WithFields(logrus.Fields{
+			"task_id":   t.ID,
+			"task_type": t.Type,
+			"until":     until,
+		}).Warn("Task type is quarantined, failing task")
+
+		errMsg := fmt.Sprintf("task type %q is quarantined until %s after repeated panics", t.Type, until.Format(time.RFC3339))
+
+		wp.deadLetter(ctx, t, errMsg)
+		wp.submitResult(ctx, results, task.Result{
+			TaskID:     t.ID,
+			TaskType:   t.Type,
+			ErrMsg:     errMsg,
+			State:      task.StateFailed,
+			Attempt:    t.Attempt,
+			EnqueuedAt: t.EnqueuedAt,
+			FinishedAt: time.Now(),
+			Metadata:   t.Metadata,
+		})
+
+		return
+	}
+
+	if !held.slotHeld && !wp.acquireSlot(ctx, taskQueue, held) {
+		logrus.WithFields(logrus.Fields{
+			"task_id":   t.ID,
+			"task_type": t.Type,
+		}).Info("Task type is at its concurrency limit, holding task")
+
+		return
+	}
+
+	if !held.tokenTaken && wp.holdForRateLimit(ctx, taskQueue, t) {
+		logrus.WithFields(logrus.Fields{
+			"task_id":   t.ID,
+			"task_type": t.Type,
+		}).Info("Task type is over its rate limit, holding task")
+
+		wp.releaseSlot(t.Type)
+
+		return
+	}
+
+	t.Attempt++
+
+	startedAt := time.Now()
+
+	reportState(ctx, results, task.Result{
+		TaskID:     t.ID,
+		TaskType:   t.Type,
+		State:      task.StateRunning,
+		Attempt:    t.Attempt,
+		EnqueuedAt: t.EnqueuedAt,
+		StartedAt:  startedAt,
+		Metadata:   t.Metadata,
+	})
+
+	handler = task.Chain(handler, wp.opts.middleware...)
+
+	result, wasCancelled := wp.runHandler(ctx, taskQueue, handler, t)
+
+	result.State = task.StateSucceeded
+	result.Attempt = t.Attempt
+	result.EnqueuedAt = t.EnqueuedAt
+	result.StartedAt = startedAt
+	result.FinishedAt = time.Now()
+
+	switch {
+	case wasCancelled:
+		result.State = task.StateCancelled
+
+	case result.ErrMsg != "":
+		result.State = task.StateFailed
+
+		logrus.WithFields(logrus.Fields{
+			"task_id": t.ID,
+			"attempt": t.Attempt,
+			"error":   result.ErrMsg,
+		}).Error("Failed to process task")
+
+		if policy, ok := wp.opts.retryPolicies[t.Type]; ok && policy.ShouldRetry(t, result) {
+			reportState(ctx, results, task.Result{
+				TaskID:     t.ID,
+				TaskType:   t.Type,
+				State:      task.StatePending,
+				Attempt:    t.Attempt + 1,
+				EnqueuedAt: t.EnqueuedAt,
+				Metadata:   t.Metadata,
+			})
+
+			wp.retry(ctx, taskQueue, t, policy.Delay(t.Attempt))
+
+			return
+		}
+
+		wp.deadLetter(ctx, t, result.ErrMsg)
+	}
+
+	wp.submitResult(ctx, results, result)
+}
+
+// deadLetter records the task in the dead letter queue, if the pool has one.
+func (wp *Pool) deadLetter(ctx context.Context, t task.Task, reason string) {
+	if wp.opts.deadLetters == nil {
+		return
+	}
+
+	err := wp.opts.deadLetters.Add(ctx, deadletter.Entry{
+		Task:     t,
+		Reason:   reason,
+		Attempts: t.Attempt,
+		FailedAt: time.Now(),
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"task_id": t.ID,
+			"error":   err,
+		}).Error("Failed to dead-letter task")
+	}
+}
+
+func (wp *Pool) submitResult(ctx context.Context, results task.Submitter[task.Result], result task.Result) {
+	err := results.Submit(ctx, result)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"task_id": result.TaskID,
+			"error":   err,
+		}).Error("Failed to write result")
+	}
+}
+
+// reportState sends a result that only reports a change in the task's state, so
+// that GoFlow can track tasks that have not finished yet.
+func reportState(ctx context.Context, results task.Submitter[task.Result], update task.Result) {
+	err := results.Submit(ctx, update)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"task_id": update.TaskID,
+			"state":   update.State,
+			"error":   err,
+		}).Error("Failed to report task state")
+	}
+}
+
+// runHandler executes the handler with a context scoped to the single task. The
+// task context is derived from the pool context, so handlers observe shutdown. If
+// the task has a timeout and the handler does not return before it passes, a
+// timeout result is returned straight away and the handler is left to observe the
+// cancelled context in the background, holding the task type's concurrency slot
+// until it returns. The same happens if the task is cancelled
+// while it runs, in which case runHandler also reports that it was cancelled.
+func (wp *Pool) runHandler(ctx context.Context, taskQueue TaskQueue, handler task.Handler, t task.Task) (task.Result, bool) {
+	taskCtx, cancel := taskContext(ctx, t)
+	defer cancel(nil)
+
+	// Panics recovered by middleware still count towards quarantine
+	taskCtx = task.WithPanicReporter(taskCtx, func(recovered any, stack []byte) {
+		wp.recordPanic(t, recovered, stack)
+	})
+
+	if canceller, ok := taskQueue.(task.Canceller); ok {
+		wp.wg.Add(1)
+
+		go wp.watchCancellation(taskCtx, canceller, t.ID, cancel)
+	}
+
+	// Buffered so that a handler finishing after its timeout does not block forever
+	done := make(chan task.Result, 1)
+
+	go func() {
+		// The slot is held until the handler returns, even if the task has already
+		// timed out or been cancelled, so orphaned handlers still count towards the
+		// concurrency limit
+		defer wp.releaseSlot(t.Type)
+
+		// A panic in the handler fails the task instead of crashing the process
+		defer func() {
+			if r := recover(); r != nil {
+				done <- wp.handlePanic(t, r, debug.Stack())
+			}
+		}()
+
+		done <- handler(taskCtx, t)
+	}()
+
+	var result task.Result
+
+	wasCancelled := false
+
+	select {
+	case result = <-done:
+		// A handler that returns early because it was cancelled usually reports
+		// the cancellation as an error
+		wasCancelled = result.ErrMsg != "" && errors.Is(context.Cause(taskCtx), errTaskCancelled)
+
+	case <-taskCtx.Done():
+		switch {
+		case errors.Is(context.Cause(taskCtx), errTaskCancelled):
+			wasCancelled = true
+
+		case errors.Is(taskCtx.Err(), context.DeadlineExceeded):
+			logrus.WithFields(logrus.Fields{
+				"task_id": t.ID,
+				"timeout": t.Timeout,
+			}).Warn("Task timed out")
+
+			result = task.Result{ErrMsg: fmt.Sprintf("task timed out after %s", t.Timeout)}
+
+		default:
+			// The pool is shutting down, so wait for the handler to return gracefully
+			result = <-done
+		}
+	}
+
+	if wasCancelled {
+		logrus.WithFields(logrus.Fields{
+			"task_id": t.ID,
+		}).Info("Task cancelled")
+
+		result = task.Result{ErrMsg: task.CancelledErrMsg}
+	}
+
+	result.TaskID = t.ID
+	result.TaskType = t.Type
+	result.Metadata = mergeMetadata(t.Metadata, result.Metadata)
+
+	return result, wasCancelled
+}
+
+// mergeMetadata returns a copy of the task's metadata with any set by its handler
+// on top, so that changing the result's metadata never changes the task's.
+func mergeMetadata(taskMetadata, resultMetadata map[string]string) map[string]string {
+	if len(resultMetadata) == 0 {
+		return maps.Clone(taskMetadata)
+	}
+
+	merged := make(map[string]string, len(taskMetadata)+len(resultMetadata))
+	maps.Copy(merged, taskMetadata)
+	maps.Copy(merged, resultMetadata)
+
+	return merged
+}
+
+// handlePanic records a panic in the task's handler and returns the failed result
+// for the task.
+func (wp *Pool) handlePanic(t task.Task, recovered any, stack []byte) task.Result {
+	wp.recordPanic(t, recovered, stack)
+
+	return task.Result{ErrMsg: fmt.Sprintf("handler panicked: %v\n\n%s", recovered, stack)}
+}
+
+// recordPanic counts a panic in the task's handler, quarantining the task type if it
+// has now panicked too many times.
+func (wp *Pool) recordPanic(t task.Task, recovered any, stack []byte) {
+	logrus.WithFields(logrus.Fields{
+		"task_id":   t.ID,
+		"task_type": t.Type,
+		"panic":     recovered,
+		"stack":     string(stack),
+	}).Error("Handler panicked")
+
+	wp.panicsMu.Lock()
+	defer wp.panicsMu.Unlock()
+
+	if wp.panics == nil {
+		wp.panics = map[string]*panicRecord{}
+	}
+
+	record, ok := wp.panics[t.Type]
+	if !ok {
+		record = &panicRecord{}
+		wp.panics[t.Type] = record
+	}
+
+	record.total++
+	record.sinceQuarantine++
+
+	if wp.opts.panicThreshold > 0 && record.sinceQuarantine >= wp.opts.panicThreshold {
+		record.sinceQuarantine = 0
+		record.quarantinedUntil = time.Now().Add(wp.opts.quarantinePeriod)
+
+		logrus.WithFields(logrus.Fields{
+			"task_type": t.Type,
+			"until":     record.quarantinedUntil,
+		}).Error("Quarantining task type after repeated panics")
+	}
+}
+
+// quarantinedUntil returns when the quarantine of the task type ends, if it is
+// quarantined.
+func (wp *Pool) quarantinedUntil(taskType string) (time.Time, bool) {
+	wp.panicsMu.Lock()
+	defer wp.panicsMu.Unlock()
+
+	record, ok := wp.panics[taskType]
+	if !ok || !time.Now().Before(record.quarantinedUntil) {
+		return time.Time{}, false
+	}
+
+	return record.quarantinedUntil, true
+}
+
+// taskContext returns the context for a single attempt at the task, which can be
+// cancelled with a cause.
+func taskContext(ctx context.Context, t task.Task) (context.Context, context.CancelCauseFunc) {
+	taskCtx, cancel := context.WithCancelCause(ctx)
+
+	if t.Timeout <= 0 {
+		return taskCtx, cancel
+	}
+
+	timeoutCtx, cancelTimeout := context.WithTimeout(taskCtx, t.Timeout)
+
+	return timeoutCtx, func(cause error) {
+		cancelTimeout()
+		cancel(cause)
+	}
+}
+
+// watchCancellation checks whether the task has been cancelled every cancel poll
+// interval until ctx is done, cancelling the task's context if it has.
+func (wp *Pool) watchCancellation(
+	ctx context.Context,
+	canceller task.Canceller,
+	taskID string,
+	cancel context.CancelCauseFunc,
+) {
+	defer wp.wg.Done()
+
+	interval := wp.opts.cancelPollInterval
+	if interval <= 0 {
+		interval = defaultCancelPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			if cancelled(ctx, canceller, taskID) {
+				cancel(errTaskCancelled)
+
+				return
+			}
+		}
+	}
+}
+
+// cancelled reports whether the task has been cancelled, if the broker supports
+// cancellation. Tasks are assumed not to be cancelled if the check fails.
+func cancelled(ctx context.Context, queue any, taskID string) bool {
+	canceller, ok := queue.(task.Canceller)
+	if !ok {
+		return false
+	}
+
+	isCancelled, err := canceller.Cancelled(ctx, taskID)
+	if err != nil {
+		if ctx.Err() == nil {
+			logrus.WithFields(logrus.Fields{
+				"task_id": taskID,
+				"error":   err,
+			}).Warn("Failed to check whether task was cancelled")
+		}
+
+		return false
+	}
+
+	return isCancelled
+}
+
+// acquireSlot reserves one of the slots for the task's type if it has a concurrency
+// limit, reporting whether it did. If they are all taken, or tasks of the type are
+// already waiting for one, the task is held until a slot is free for it instead.
+func (wp *Pool) acquireSlot(ctx context.Context, taskQueue task.Submitter[task.Task], held heldTask) bool {
+	limit, ok := wp.opts.concurrencyLimits[held.Type]
+	if !ok {
+		return true
+	}
+
+	wp.runningMu.Lock()
+	defer wp.runningMu.Unlock()
+
+	blocked, waiting := wp.blocked[held.Type]
+	if !waiting && wp.running[held.Type] < limit {
+		wp.running[held.Type]++
+
+		return true
+	}
+
+	wp.blocked[held.Type] = append(blocked, held)
+
+	if !waiting {
+		wp.wg.Add(1)
+
+		go wp.releaseBlocked(ctx, taskQueue, held.Type)
+	}
+
+	return false
+}
+
+// releaseSlot frees a slot reserved by acquireSlot.
+func (wp *Pool) releaseSlot(taskType string) {
+	if _, ok := wp.opts.concurrencyLimits[taskType]; !ok {
+		return
+	}
+
+	wp.runningMu.Lock()
+	wp.running[taskType]--
+	wp.runningMu.Unlock()
+
+	select {
+	case wp.slotFreed[taskType] <- struct{}{}:
+	default:
+	}
+}
+
+// releaseBlocked hands the tasks blocked by the type's concurrency limit to the
+// workers one at a time, in order, as slots are freed, each with its slot already
+// reserved. It returns once no tasks of the type are blocked. If the pool shuts
+// down first, the blocked tasks are put back on the task queue.
+func (wp *Pool) releaseBlocked(ctx context.Context, taskQueue task.Submitter[task.Task], taskType string) {
+	defer wp.wg.Done()
+
+	for {
+		held, ok, last := wp.popBlocked(taskType)
+		if !ok {
+			select {
+			case <-ctx.Done():
+				wp.requeueHeld(ctx, taskQueue, wp.takeBlocked(taskType))
+
+				return
 
+			case <-wp.slotFreed[taskType]:
 				continue
 			}
+		}
 
-			result := handler(t.Payload)
-			result.TaskID = t.ID
+		held.slotHeld = true
 
-			if result.ErrMsg != "" {
-				logrus.WithFields(logrus.Fields{
-					"task_id": t.ID,
-					"error":   result.ErrMsg,
-				}).Error("Failed to process task")
+		select {
+		case <-ctx.Done():
+			wp.releaseSlot(taskType)
+
+			requeue := []task.Task{held.Task}
+			if !last {
+				requeue = append(requeue, wp.takeBlocked(taskType)...)
 			}
 
-			err := results.Submit(ctx, result)
+			wp.requeueHeld(ctx, taskQueue, requeue)
+
+			return
+
+		case wp.released <- held:
+		}
+
+		if last {
+			return
+		}
+	}
+}
+
+// popBlocked reserves a slot for the first task blocked on the type and removes it,
+// if a slot is free, reporting whether it did and whether the task was the last one.
+func (wp *Pool) popBlocked(taskType string) (heldTask, bool, bool) {
+	wp.runningMu.Lock()
+	defer wp.runningMu.Unlock()
+
+	if wp.running[taskType] >= wp.opts.concurrencyLimits[taskType] {
+		return heldTask{}, false, false
+	}
+
+	wp.running[taskType]++
+
+	blocked := wp.blocked[taskType]
+
+	held := blocked[0]
+	if len(blocked) == 1 {
+		delete(wp.blocked, taskType)
+
+		return held, true, true
+	}
+
+	wp.blocked[taskType] = blocked[1:]
+
+	return held, true, false
+}
+
+// takeBlocked removes and returns every task blocked on the type.
+func (wp *Pool) takeBlocked(taskType string) []task.Task {
+	wp.runningMu.Lock()
+	defer wp.runningMu.Unlock()
+
+	blocked := make([]task.Task, 0, len(wp.blocked[taskType]))
+	for _, held := range wp.blocked[taskType] {
+		blocked = append(blocked, held.Task)
+	}
+
+	delete(wp.blocked, taskType)
+
+	return blocked
+}
+
+// rateLimitWait takes a token for the task type if it has a rate limit, returning
+// how long to wait before a task of the type can run if none is available. Tasks
+// are run if the limiter fails, so that an unavailable limiter does not stall them.
+func (wp *Pool) rateLimitWait(ctx context.Context, taskType string) time.Duration {
+	limit, ok := wp.opts.rateLimits[taskType]
+	if !ok {
+		return 0
+	}
+
+	wait, err := wp.opts.rateLimiter.Take(ctx, taskType, limit)
+	if err != nil {
+		if ctx.Err() == nil {
+			logrus.WithFields(logrus.Fields{
+				"task_type": taskType,
+				"error":     err,
+			}).Warn("Failed to check rate limit")
+		}
+
+		return 0
+	}
+
+	return wait
+}
+
+// holdForRateLimit holds the task in the pool if its type is over its rate limit,
+// reporting whether it did. Tasks of a type that already has tasks held are held
+// behind them without taking a token, so that they run in the order they were
+// picked up.
+func (wp *Pool) holdForRateLimit(ctx context.Context, taskQueue task.Submitter[task.Task], t task.Task) bool {
+	if _, ok := wp.opts.rateLimits[t.Type]; !ok {
+		return false
+	}
+
+	if wp.holdBehind(t) {
+		return true
+	}
+
+	wait := wp.rateLimitWait(ctx, t.Type)
+	if wait <= 0 {
+		return false
+	}
+
+	if wp.holdBehind(t) {
+		return true
+	}
+
+	wp.rateHeldMu.Lock()
+	defer wp.rateHeldMu.Unlock()
+
+	wp.rateHeld[t.Type] = []task.Task{t}
+
+	wp.wg.Add(1)
+
+	go wp.releaseRateLimited(ctx, taskQueue, t.Type, wait)
+
+	return true
+}
+
+// holdBehind adds the task to the back of the tasks held for its type, if there are
+// any, reporting whether it did.
+func (wp *Pool) holdBehind(t task.Task) bool {
+	wp.rateHeldMu.Lock()
+	defer wp.rateHeldMu.Unlock()
+
+	held, ok := wp.rateHeld[t.Type]
+	if !ok {
+		return false
+	}
+
+	wp.rateHeld[t.Type] = append(held, t)
+
+	return true
+}
+
+// releaseRateLimited hands the tasks held for the type to the workers one at a
+// time, in order, as tokens become available, starting once wait has passed. It
+// returns once no tasks of the type are held. If the pool shuts down first, the
+// held tasks are put back on the task queue.
+func (wp *Pool) releaseRateLimited(ctx context.Context, taskQueue task.Submitter[task.Task], taskType string, wait time.Duration) {
+	defer wp.wg.Done()
+
+	for {
+		if !sleep(ctx, wait) {
+			wp.requeueHeld(ctx, taskQueue, wp.takeRateHeld(taskType))
+
+			return
+		}
+
+		wait = wp.rateLimitWait(ctx, taskType)
+		if wait > 0 {
+			continue
+		}
 
-			if err != nil {
-				logrus.WithFields(logrus.Fields{
-					"task_id": t.ID,
-					"error":   err,
-				}).Error("Failed to write result")
+		t, last := wp.popRateHeld(taskType)
+
+		select {
+		case <-ctx.Done():
+			requeue := []task.Task{t}
+			if !last {
+				requeue = append(requeue, wp.takeRateHeld(taskType)...)
 			}
+
+			wp.requeueHeld(ctx, taskQueue, requeue)
+
+			return
+
+		case wp.released <- heldTask{Task: t, tokenTaken: true}:
 		}
+
+		if last {
+			return
+		}
+	}
+}
+
+// popRateHeld removes the first task held for the type, reporting whether it was
+// the last one.
+func (wp *Pool) popRateHeld(taskType string) (task.Task, bool) {
+	wp.rateHeldMu.Lock()
+	defer wp.rateHeldMu.Unlock()
+
+	held := wp.rateHeld[taskType]
+
+	t := held[0]
+	if len(held) == 1 {
+		delete(wp.rateHeld, taskType)
+
+		return t, true
 	}
+
+	wp.rateHeld[taskType] = held[1:]
+
+	return t, false
+}
+
+// takeRateHeld removes and returns every task held for the type.
+func (wp *Pool) takeRateHeld(taskType string) []task.Task {
+	wp.rateHeldMu.Lock()
+	defer wp.rateHeldMu.Unlock()
+
+	held := wp.rateHeld[taskType]
+	delete(wp.rateHeld, taskType)
+
+	return held
+}
+
+// requeueHeld puts tasks held by the pool back on the task queue when it shuts
+// down, so that they are not lost.
+func (wp *Pool) requeueHeld(ctx context.Context, taskQueue task.Submitter[task.Task], tasks []task.Task) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), requeueHeldTimeout)
+	defer cancel()
+
+	for _, t := range tasks {
+		if err := taskQueue.Submit(ctx, t); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"task_id": t.ID,
+				"error":   err,
+			}).Error("Failed to requeue held task")
+		}
+	}
+}
+
+// sleep waits for the duration, reporting false if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+
+	case <-timer.C:
+		return true
+	}
+}
+
+// retry puts the task back on the task queue once the backoff delay has passed.
+func (wp *Pool) retry(ctx context.Context, taskQueue task.Submitter[task.Task], t task.Task, delay time.Duration) {
+	logrus.WithFields(logrus.Fields{
+		"task_id": t.ID,
+		"attempt": t.Attempt,
+		"delay":   delay,
+	}).Info("Scheduling task for retry")
+
+	wp.resubmit(ctx, taskQueue, t, delay)
+}
+
+// resubmit puts the task back on the task queue once the delay has passed. If the
+// queue supports delayed submission the wait is left to the broker. Otherwise the
+// wait happens in its own goroutine so that the worker is free to pick up other
+// tasks in the meantime.
+func (wp *Pool) resubmit(ctx context.Context, taskQueue task.Submitter[task.Task], t task.Task, delay time.Duration) {
+	if delayed, ok := taskQueue.(task.DelayedSubmitter[task.Task]); ok {
+		if err := delayed.SubmitAt(ctx, t, time.Now().Add(delay)); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"task_id": t.ID,
+				"error":   err,
+			}).Error("Failed to resubmit task")
+		}
+
+		return
+	}
+
+	wp.wg.Add(1)
+
+	go func() {
+		defer wp.wg.Done()
+
+		if !sleep(ctx, delay) {
+			logrus.WithFields(logrus.Fields{
+				"task_id": t.ID,
+			}).Warn("Received shutdown signal, abandoning resubmission")
+
+			return
+		}
+
+		if err := taskQueue.Submit(ctx, t); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"task_id": t.ID,
+				"error":   err,
+			}).Error("Failed to resubmit task")
+		}
+	}()
 }
@@ -7,9 +7,14 @@ import (
 	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/jamesTait-jt/goflow/broker"
+	"github.com/jamesTait-jt/goflow/deadletter"
+	"github.com/jamesTait-jt/goflow/middleware"
 	"github.com/jamesTait-jt/goflow/pkg/store"
+	"github.com/jamesTait-jt/goflow/ratelimit"
+	"github.com/jamesTait-jt/goflow/retry"
 	"github.com/jamesTait-jt/goflow/task"
 	"github.com/stretchr/testify/assert"
 )
@@ -26,6 +31,28 @@ func TestNew(t *testing.T) {
 		assert.Equal(t, numWorkers, wp.numWorkers)
 		assert.NotNil(t, wp.wg)
 	})
+
+	t.Run("Applies the pool options", func(t *testing.T) {
+		// Arrange
+		policies := map[string]retry.Policy{
+			"test_task": {MaxAttempts: 3},
+		}
+
+		// Act
+		wp := New(1, WithRetryPolicies(policies))
+
+		// Assert
+		assert.Equal(t, policies, wp.opts.retryPolicies)
+		assert.Equal(t, defaultCancelPollInterval, wp.opts.cancelPollInterval)
+		assert.IsType(t, &ratelimit.InMemoryLimiter{}, wp.opts.rateLimiter)
+	})
+
+	t.Run("Panics if a rate limit's rate is not positive", func(t *testing.T) {
+		// Act & Assert
+		assert.Panics(t, func() {
+			WithRateLimits(map[string]ratelimit.Limit{"test_task": {Rate: 0, Burst: 1}})
+		})
+	})
 }
 
 func Test_Pool_Start(t *testing.T) {
@@ -51,7 +78,7 @@ func Test_Pool_Start(t *testing.T) {
 
 		handlerCalled := false
 
-		taskHandlers.Put(taskType, func(_ any) task.Result {
+		taskHandlers.Put(taskType, func(_ context.Context, _ task.Task) task.Result {
 			handlerCalled = true
 
 			return resultToReturn
@@ -61,11 +88,12 @@ func Test_Pool_Start(t *testing.T) {
 		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
 		_ = taskQueue.Submit(ctx, submittedTask)
 
-		receivedResult := <-resultQueue.Dequeue(ctx)
+		receivedResult := finalResult(ctx, resultQueue)
 
 		// Assert
 		assert.True(t, handlerCalled)
 		assert.Equal(t, submittedTask.ID, resultToReturn.TaskID)
+		assert.Equal(t, taskType, receivedResult.TaskType)
 		assert.Equal(t, resultToReturn.Payload, receivedResult.Payload)
 		assert.Equal(t, "", receivedResult.ErrMsg)
 
@@ -95,7 +123,7 @@ func Test_Pool_Start(t *testing.T) {
 
 		handlerCalled := false
 
-		taskHandlers.Put(taskType, func(_ any) task.Result {
+		taskHandlers.Put(taskType, func(_ context.Context, _ task.Task) task.Result {
 			handlerCalled = true
 
 			return resultToReturn
@@ -104,7 +132,7 @@ func Test_Pool_Start(t *testing.T) {
 		// Act
 		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
 		_ = taskQueue.Submit(ctx, submittedTask)
-		receivedResult := <-resultQueue.Dequeue(ctx)
+		receivedResult := finalResult(ctx, resultQueue)
 
 		// Assert
 		assert.True(t, handlerCalled)
@@ -116,7 +144,7 @@ func Test_Pool_Start(t *testing.T) {
 		wg.Wait()
 	})
 
-	t.Run("Skips task if handler not registered for type", func(t *testing.T) {
+	t.Run("Fails task if handler not registered for type", func(t *testing.T) {
 		// Arrange
 		ctx, cancel := context.WithCancel(context.Background())
 
@@ -137,7 +165,7 @@ func Test_Pool_Start(t *testing.T) {
 
 		handlerCalled := false
 
-		taskHandlers.Put("not a key", func(_ any) task.Result {
+		taskHandlers.Put("not a key", func(_ context.Context, _ task.Task) task.Result {
 			handlerCalled = true
 
 			return resultToReturn
@@ -147,10 +175,1210 @@ func Test_Pool_Start(t *testing.T) {
 		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
 		_ = taskQueue.Submit(ctx, submittedTask)
 
+		receivedResult := <-resultQueue.Dequeue(ctx)
+
 		// Assert
 		assert.False(t, handlerCalled)
+		assert.Equal(t, task.StateFailed, receivedResult.State)
+		assert.Equal(t, `no handler registered for task type "test_task"`, receivedResult.ErrMsg)
+
+		cancel()
+		wg.Wait()
+	})
+
+	t.Run("Passes the task and a context derived from the pool context to the handler", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](0)
+		// Buffered for the running state update, as nothing reads the results
+		resultQueue := broker.NewChannelBroker[task.Result](1)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		wg := &sync.WaitGroup{}
+		wp := Pool{numWorkers: 1, wg: wg}
+
+		submittedTask := task.Task{ID: "task-id", Type: "test_task", Payload: "payload"}
+
+		var receivedTask task.Task
+
+		handlerStarted := make(chan struct{})
+
+		taskHandlers.Put(submittedTask.Type, func(handlerCtx context.Context, tsk task.Task) task.Result {
+			receivedTask = tsk
 
+			close(handlerStarted)
+			<-handlerCtx.Done()
+
+			return task.Result{ErrMsg: handlerCtx.Err().Error()}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, submittedTask)
+
+		<-handlerStarted
 		cancel()
 		wg.Wait()
+
+		// Assert
+		expectedTask := submittedTask
+		expectedTask.Attempt = 1
+
+		assert.Equal(t, expectedTask, receivedTask)
+	})
+}
+
+func Test_Pool_StateReporting(t *testing.T) {
+	t.Run("Reports that the task is running before submitting the final result", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](0)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		wp := New(1)
+
+		enqueuedAt := time.Now().Add(-time.Minute)
+		submittedTask := task.Task{ID: "task-id", Type: "test_task", EnqueuedAt: enqueuedAt}
+
+		taskHandlers.Put(submittedTask.Type, func(_ context.Context, _ task.Task) task.Result {
+			return task.Result{Payload: "done"}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, submittedTask)
+
+		running := <-resultQueue.Dequeue(ctx)
+		final := <-resultQueue.Dequeue(ctx)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "task-id", running.TaskID)
+		assert.Equal(t, task.StateRunning, running.State)
+		assert.Equal(t, 1, running.Attempt)
+		assert.Equal(t, enqueuedAt, running.EnqueuedAt)
+		assert.False(t, running.StartedAt.IsZero())
+		assert.Nil(t, running.Payload)
+
+		assert.Equal(t, task.StateSucceeded, final.State)
+		assert.Equal(t, "done", final.Payload)
+		assert.Equal(t, 1, final.Attempt)
+		assert.Equal(t, enqueuedAt, final.EnqueuedAt)
+		assert.Equal(t, running.StartedAt, final.StartedAt)
+		assert.False(t, final.FinishedAt.Before(final.StartedAt))
+	})
+
+	t.Run("Reports that a task is pending again when it is retried", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](1)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		taskType := "test_task"
+		wp := New(1, WithRetryPolicies(map[string]retry.Policy{taskType: {MaxAttempts: 2}}))
+
+		taskHandlers.Put(taskType, func(_ context.Context, _ task.Task) task.Result {
+			return task.Result{ErrMsg: "always fails"}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "task-id", Type: taskType})
+
+		var states []task.State
+
+		for {
+			result := <-resultQueue.Dequeue(ctx)
+			states = append(states, result.State)
+
+			if result.State.Terminal() {
+				break
+			}
+		}
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, []task.State{task.StateRunning, task.StatePending, task.StateRunning, task.StateFailed}, states)
+	})
+}
+
+func Test_Pool_Metadata(t *testing.T) {
+	t.Run("Copies the task metadata onto its results, with any set by the handler on top", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](0)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		wp := New(1)
+
+		submittedTask := task.Task{
+			ID:       "task-id",
+			Type:     "test_task",
+			Metadata: map[string]string{"trace_id": "abc", "tenant": "acme"},
+		}
+
+		var handledMetadata map[string]string
+
+		taskHandlers.Put(submittedTask.Type, func(_ context.Context, t task.Task) task.Result {
+			handledMetadata = t.Metadata
+
+			return task.Result{Metadata: map[string]string{"tenant": "other", "region": "eu"}}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, submittedTask)
+
+		running := <-resultQueue.Dequeue(ctx)
+		final := <-resultQueue.Dequeue(ctx)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, submittedTask.Metadata, handledMetadata)
+		assert.Equal(t, submittedTask.Metadata, running.Metadata)
+		assert.Equal(t, map[string]string{"trace_id": "abc", "tenant": "other", "region": "eu"}, final.Metadata)
+	})
+}
+
+func Test_mergeMetadata(t *testing.T) {
+	t.Run("Returns a copy of the task metadata if the handler sets none", func(t *testing.T) {
+		// Arrange
+		taskMetadata := map[string]string{"trace_id": "abc"}
+
+		// Act
+		merged := mergeMetadata(taskMetadata, nil)
+		merged["trace_id"] = "changed"
+
+		// Assert
+		assert.Equal(t, map[string]string{"trace_id": "abc"}, taskMetadata)
+	})
+
+	t.Run("Returns nil if neither the task nor the handler set any", func(t *testing.T) {
+		// Act
+		merged := mergeMetadata(nil, nil)
+
+		// Assert
+		assert.Nil(t, merged)
+	})
+}
+
+func Test_Pool_Retry(t *testing.T) {
+	t.Run("Retries a failed task and only submits the final result", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](1)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		taskType := "test_task"
+		wp := New(1, WithRetryPolicies(map[string]retry.Policy{
+			taskType: {MaxAttempts: 3, Backoff: retry.Fixed(time.Millisecond)},
+		}))
+
+		var attempts []int
+
+		taskHandlers.Put(taskType, func(_ context.Context, tsk task.Task) task.Result {
+			attempts = append(attempts, tsk.Attempt)
+
+			if tsk.Attempt < 2 {
+				return task.Result{ErrMsg: "transient"}
+			}
+
+			return task.Result{Payload: "done"}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "task-id", Type: taskType})
+
+		receivedResult := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, []int{1, 2}, attempts)
+		assert.Equal(t, "task-id", receivedResult.TaskID)
+		assert.Equal(t, "done", receivedResult.Payload)
+		assert.Equal(t, task.StateSucceeded, receivedResult.State)
+		assert.Equal(t, 2, receivedResult.Attempt)
+	})
+
+	t.Run("Submits the failure once the maximum number of attempts is reached", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](1)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		taskType := "test_task"
+		wp := New(1, WithRetryPolicies(map[string]retry.Policy{
+			taskType: {MaxAttempts: 3},
+		}))
+
+		numCalls := 0
+
+		taskHandlers.Put(taskType, func(_ context.Context, _ task.Task) task.Result {
+			numCalls++
+
+			return task.Result{ErrMsg: "always fails"}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "task-id", Type: taskType})
+
+		receivedResult := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, 3, numCalls)
+		assert.Equal(t, "always fails", receivedResult.ErrMsg)
+	})
+
+	t.Run("Waits for the backoff itself if the queue does not support delayed submission", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		channelBroker := broker.NewChannelBroker[task.Task](1)
+		taskQueue := undelayedQueue{Submitter: channelBroker, Dequeuer: channelBroker}
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		taskType := "test_task"
+		wp := New(1, WithRetryPolicies(map[string]retry.Policy{
+			taskType: {MaxAttempts: 2, Backoff: retry.Fixed(10 * time.Millisecond)},
+		}))
+
+		var attemptTimes []time.Time
+
+		taskHandlers.Put(taskType, func(_ context.Context, _ task.Task) task.Result {
+			attemptTimes = append(attemptTimes, time.Now())
+
+			return task.Result{ErrMsg: "always fails"}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "task-id", Type: taskType})
+
+		receivedResult := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "always fails", receivedResult.ErrMsg)
+		assert.Len(t, attemptTimes, 2)
+		assert.GreaterOrEqual(t, attemptTimes[1].Sub(attemptTimes[0]), 10*time.Millisecond)
+	})
+
+	t.Run("Does not retry failures rejected by the classifier", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](1)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		taskType := "test_task"
+		wp := New(1, WithRetryPolicies(map[string]retry.Policy{
+			taskType: {
+				MaxAttempts: 3,
+				Retryable:   func(r task.Result) bool { return r.ErrMsg != "permanent" },
+			},
+		}))
+
+		numCalls := 0
+
+		taskHandlers.Put(taskType, func(_ context.Context, _ task.Task) task.Result {
+			numCalls++
+
+			return task.Result{ErrMsg: "permanent"}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "task-id", Type: taskType})
+
+		receivedResult := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, 1, numCalls)
+		assert.Equal(t, "permanent", receivedResult.ErrMsg)
+	})
+}
+
+func Test_Pool_Timeout(t *testing.T) {
+	t.Run("Submits a timeout result if the handler runs past the task timeout", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](0)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		wp := New(1)
+
+		taskType := "test_task"
+		release := make(chan struct{})
+
+		taskHandlers.Put(taskType, func(_ context.Context, _ task.Task) task.Result {
+			// Ignores the context to simulate a hanging handler
+			<-release
+
+			return task.Result{Payload: "too late"}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "task-id", Type: taskType, Timeout: 10 * time.Millisecond})
+
+		receivedResult := finalResult(ctx, resultQueue)
+
+		close(release)
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "task-id", receivedResult.TaskID)
+		assert.Equal(t, "task timed out after 10ms", receivedResult.ErrMsg)
+		assert.Equal(t, task.StateFailed, receivedResult.State)
+	})
+
+	t.Run("Cancels the handler context when the timeout passes", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](0)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		wp := New(1)
+
+		taskType := "test_task"
+		handlerErr := make(chan error, 1)
+
+		taskHandlers.Put(taskType, func(handlerCtx context.Context, _ task.Task) task.Result {
+			<-handlerCtx.Done()
+			handlerErr <- handlerCtx.Err()
+
+			return task.Result{}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "task-id", Type: taskType, Timeout: 10 * time.Millisecond})
+
+		finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.ErrorIs(t, <-handlerErr, context.DeadlineExceeded)
 	})
 }
+
+func Test_Pool_Cancellation(t *testing.T) {
+	t.Run("Skips a task that was cancelled while queued", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](1)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		wp := New(1)
+
+		taskType := "test_task"
+		handled := []string{}
+
+		taskHandlers.Put(taskType, func(_ context.Context, t task.Task) task.Result {
+			handled = append(handled, t.ID)
+
+			return task.Result{}
+		})
+
+		_ = taskQueue.Cancel(ctx, "cancelled")
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "cancelled", Type: taskType})
+		_ = taskQueue.Submit(ctx, task.Task{ID: "task-id", Type: taskType})
+
+		receivedResult := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "task-id", receivedResult.TaskID)
+		assert.Equal(t, []string{"task-id"}, handled)
+	})
+
+	t.Run("Cancels the handler context and submits a cancelled result for a running task", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](0)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		wp := New(1, WithCancelPollInterval(time.Millisecond))
+
+		taskType := "test_task"
+		handlerErr := make(chan error, 1)
+
+		taskHandlers.Put(taskType, func(handlerCtx context.Context, _ task.Task) task.Result {
+			<-handlerCtx.Done()
+			handlerErr <- handlerCtx.Err()
+
+			return task.Result{ErrMsg: handlerCtx.Err().Error()}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "task-id", Type: taskType})
+
+		running := <-resultQueue.Dequeue(ctx)
+		_ = taskQueue.Cancel(ctx, "task-id")
+
+		receivedResult := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, task.StateRunning, running.State)
+		assert.ErrorIs(t, <-handlerErr, context.Canceled)
+		assert.Equal(t, "task-id", receivedResult.TaskID)
+		assert.Equal(t, task.StateCancelled, receivedResult.State)
+		assert.Equal(t, task.CancelledErrMsg, receivedResult.ErrMsg)
+	})
+}
+
+func Test_Pool_RateLimit(t *testing.T) {
+	t.Run("Delays tasks of a type over its rate limit instead of failing them", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](2)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		taskType := "test_task"
+		wp := New(1, WithRateLimits(map[string]ratelimit.Limit{
+			taskType: {Rate: 20, Burst: 1},
+		}))
+
+		var runTimes []time.Time
+
+		taskHandlers.Put(taskType, func(_ context.Context, _ task.Task) task.Result {
+			runTimes = append(runTimes, time.Now())
+
+			return task.Result{}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "first", Type: taskType})
+		_ = taskQueue.Submit(ctx, task.Task{ID: "second", Type: taskType})
+
+		firstResult := finalResult(ctx, resultQueue)
+		secondResult := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, task.StateSucceeded, firstResult.State)
+		assert.Equal(t, task.StateSucceeded, secondResult.State)
+		assert.Equal(t, 1, secondResult.Attempt)
+		assert.Len(t, runTimes, 2)
+		assert.GreaterOrEqual(t, runTimes[1].Sub(runTimes[0]), 40*time.Millisecond)
+	})
+
+	t.Run("Runs held tasks in the order they were picked up, taking few tokens", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](4)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		taskType := "test_task"
+		limiter := &countingLimiter{Limiter: ratelimit.NewInMemoryLimiter()}
+		wp := New(
+			1,
+			WithRateLimits(map[string]ratelimit.Limit{taskType: {Rate: 100, Burst: 1}}),
+			WithRateLimiter(limiter),
+		)
+
+		taskHandlers.Put(taskType, func(_ context.Context, _ task.Task) task.Result {
+			return task.Result{}
+		})
+
+		ids := []string{"first", "second", "third", "fourth"}
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+
+		for _, id := range ids {
+			_ = taskQueue.Submit(ctx, task.Task{ID: id, Type: taskType})
+		}
+
+		var order []string
+
+		for range ids {
+			order = append(order, finalResult(ctx, resultQueue).TaskID)
+		}
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, ids, order)
+		assert.LessOrEqual(t, limiter.takes(), 2*len(ids))
+	})
+
+	t.Run("Puts held tasks back on the task queue when the pool shuts down", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := &recordingQueue{TaskQueue: broker.NewChannelBroker[task.Task](2)}
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		taskType := "test_task"
+		wp := New(1, WithRateLimits(map[string]ratelimit.Limit{
+			taskType: {Rate: 0.001, Burst: 1},
+		}))
+
+		taskHandlers.Put(taskType, func(_ context.Context, _ task.Task) task.Result {
+			return task.Result{}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "first", Type: taskType})
+		_ = taskQueue.Submit(ctx, task.Task{ID: "held", Type: taskType})
+
+		_ = finalResult(ctx, resultQueue)
+
+		assert.Eventually(t, func() bool {
+			wp.rateHeldMu.Lock()
+			defer wp.rateHeldMu.Unlock()
+
+			return len(wp.rateHeld[taskType]) == 1
+		}, time.Second, time.Millisecond)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, []string{"first", "held", "held"}, taskQueue.submittedIDs())
+	})
+
+	t.Run("Runs the task if the rate limiter fails", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](1)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		taskType := "test_task"
+		wp := New(
+			1,
+			WithRateLimits(map[string]ratelimit.Limit{taskType: {Rate: 1, Burst: 1}}),
+			WithRateLimiter(failingLimiter{}),
+		)
+
+		taskHandlers.Put(taskType, func(_ context.Context, _ task.Task) task.Result {
+			return task.Result{Payload: "done"}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "task-id", Type: taskType})
+
+		receivedResult := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "done", receivedResult.Payload)
+	})
+}
+
+func Test_Pool_ConcurrencyLimit(t *testing.T) {
+	t.Run("Holds tasks of a type at its limit so other types can run", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](3)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		slowType := "slow_task"
+		wp := New(
+			2,
+			WithConcurrencyLimits(map[string]int{slowType: 1}),
+		)
+
+		var mu sync.Mutex
+
+		running, maxRunning := 0, 0
+		release := make(chan struct{})
+
+		taskHandlers.Put(slowType, func(_ context.Context, _ task.Task) task.Result {
+			mu.Lock()
+			running++
+			maxRunning = max(maxRunning, running)
+			mu.Unlock()
+
+			<-release
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+
+			return task.Result{}
+		})
+		taskHandlers.Put("fast_task", func(_ context.Context, _ task.Task) task.Result {
+			return task.Result{Payload: "fast"}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "slow-1", Type: slowType})
+		_ = taskQueue.Submit(ctx, task.Task{ID: "slow-2", Type: slowType})
+		_ = taskQueue.Submit(ctx, task.Task{ID: "fast", Type: "fast_task"})
+
+		fastResult := finalResult(ctx, resultQueue)
+
+		close(release)
+
+		firstSlow := finalResult(ctx, resultQueue)
+		secondSlow := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "fast", fastResult.TaskID)
+		assert.ElementsMatch(t, []string{"slow-1", "slow-2"}, []string{firstSlow.TaskID, secondSlow.TaskID})
+		assert.Equal(t, task.StateSucceeded, firstSlow.State)
+		assert.Equal(t, task.StateSucceeded, secondSlow.State)
+		assert.Equal(t, 1, maxRunning)
+	})
+
+	t.Run("Holds the slot of a timed out handler until it returns", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](2)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		slowType := "slow_task"
+		wp := New(
+			1,
+			WithConcurrencyLimits(map[string]int{slowType: 1}),
+		)
+
+		var mu sync.Mutex
+
+		running, maxRunning := 0, 0
+		release := make(chan struct{})
+
+		// Ignores its context, so it keeps running after it times out
+		taskHandlers.Put(slowType, func(_ context.Context, _ task.Task) task.Result {
+			mu.Lock()
+			running++
+			maxRunning = max(maxRunning, running)
+			mu.Unlock()
+
+			<-release
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+
+			return task.Result{}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "orphaned", Type: slowType, Timeout: time.Millisecond})
+		_ = taskQueue.Submit(ctx, task.Task{ID: "waiting", Type: slowType})
+
+		timedOut := finalResult(ctx, resultQueue)
+
+		// Gives the waiting task time to be held while the orphaned handler runs
+		time.Sleep(20 * time.Millisecond)
+
+		close(release)
+
+		waiting := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "orphaned", timedOut.TaskID)
+		assert.Contains(t, timedOut.ErrMsg, "timed out")
+		assert.Equal(t, "waiting", waiting.TaskID)
+		assert.Equal(t, 1, maxRunning)
+	})
+
+	t.Run("Runs held tasks in order without putting them back on the task queue", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := &recordingQueue{TaskQueue: broker.NewChannelBroker[task.Task](3)}
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		slowType := "slow_task"
+		wp := New(2, WithConcurrencyLimits(map[string]int{slowType: 1}))
+
+		var mu sync.Mutex
+
+		var order []string
+
+		release := make(chan struct{})
+
+		taskHandlers.Put(slowType, func(_ context.Context, t task.Task) task.Result {
+			mu.Lock()
+			order = append(order, t.ID)
+			mu.Unlock()
+
+			<-release
+
+			return task.Result{}
+		})
+
+		ids := []string{"first", "second", "third"}
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+
+		// The first task is running before the others are picked up, so that the
+		// other worker picks them up in order
+		_ = taskQueue.Submit(ctx, task.Task{ID: ids[0], Type: slowType})
+
+		assert.Eventually(t, func() bool {
+			wp.runningMu.Lock()
+			defer wp.runningMu.Unlock()
+
+			return wp.running[slowType] == 1
+		}, time.Second, time.Millisecond)
+
+		for _, id := range ids[1:] {
+			_ = taskQueue.Submit(ctx, task.Task{ID: id, Type: slowType})
+		}
+
+		assert.Eventually(t, func() bool {
+			wp.runningMu.Lock()
+			defer wp.runningMu.Unlock()
+
+			return len(wp.blocked[slowType]) == 2
+		}, time.Second, time.Millisecond)
+
+		close(release)
+
+		for range ids {
+			_ = finalResult(ctx, resultQueue)
+		}
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, ids, order)
+		assert.Equal(t, ids, taskQueue.submittedIDs())
+	})
+
+	t.Run("Puts held tasks back on the task queue when the pool shuts down", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := &recordingQueue{TaskQueue: broker.NewChannelBroker[task.Task](2)}
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		slowType := "slow_task"
+		wp := New(2, WithConcurrencyLimits(map[string]int{slowType: 1}))
+
+		taskHandlers.Put(slowType, func(ctx context.Context, _ task.Task) task.Result {
+			<-ctx.Done()
+
+			return task.Result{}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "running", Type: slowType})
+
+		assert.Eventually(t, func() bool {
+			wp.runningMu.Lock()
+			defer wp.runningMu.Unlock()
+
+			return wp.running[slowType] == 1
+		}, time.Second, time.Millisecond)
+
+		_ = taskQueue.Submit(ctx, task.Task{ID: "held", Type: slowType})
+
+		assert.Eventually(t, func() bool {
+			wp.runningMu.Lock()
+			defer wp.runningMu.Unlock()
+
+			return len(wp.blocked[slowType]) == 1
+		}, time.Second, time.Millisecond)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, []string{"running", "held", "held"}, taskQueue.submittedIDs())
+	})
+}
+
+func Test_Pool_Middleware(t *testing.T) {
+	t.Run("Wraps every handler with the middleware, the first outermost", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](1)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		suffix := func(s string) task.Middleware {
+			return func(next task.Handler) task.Handler {
+				return func(ctx context.Context, t task.Task) task.Result {
+					result := next(ctx, t)
+					result.Payload = result.Payload.(string) + s
+
+					return result
+				}
+			}
+		}
+
+		wp := New(1, WithMiddleware(suffix("-first")), WithMiddleware(suffix("-second")))
+
+		taskType := "test_task"
+		taskHandlers.Put(taskType, func(_ context.Context, _ task.Task) task.Result {
+			return task.Result{Payload: "done"}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "task-id", Type: taskType})
+
+		receivedResult := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "done-second-first", receivedResult.Payload)
+	})
+}
+
+func Test_Pool_Panics(t *testing.T) {
+	t.Run("Fails a task whose handler panics and keeps the worker running", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](2)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		wp := New(1)
+
+		taskHandlers.Put("panics", func(_ context.Context, _ task.Task) task.Result {
+			panic("boom")
+		})
+		taskHandlers.Put("succeeds", func(_ context.Context, _ task.Task) task.Result {
+			return task.Result{Payload: "done"}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "panicked", Type: "panics"})
+		_ = taskQueue.Submit(ctx, task.Task{ID: "succeeded", Type: "succeeds"})
+
+		panickedResult := finalResult(ctx, resultQueue)
+		succeededResult := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "panicked", panickedResult.TaskID)
+		assert.Equal(t, task.StateFailed, panickedResult.State)
+		assert.Contains(t, panickedResult.ErrMsg, "handler panicked: boom")
+		assert.Contains(t, panickedResult.ErrMsg, "goroutine")
+		assert.Equal(t, "done", succeededResult.Payload)
+		assert.Equal(t, map[string]int{"panics": 1}, wp.Panics())
+	})
+
+	t.Run("Fails tasks of a quarantined type without running them", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](2)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		wp := New(1, WithPanicQuarantine(1, time.Hour))
+
+		numCalls := 0
+
+		taskHandlers.Put("panics", func(_ context.Context, _ task.Task) task.Result {
+			numCalls++
+
+			panic("boom")
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "first", Type: "panics"})
+		_ = taskQueue.Submit(ctx, task.Task{ID: "second", Type: "panics"})
+
+		firstResult := finalResult(ctx, resultQueue)
+		secondResult := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, 1, numCalls)
+		assert.Contains(t, firstResult.ErrMsg, "handler panicked")
+		assert.Equal(t, "second", secondResult.TaskID)
+		assert.Equal(t, task.StateFailed, secondResult.State)
+		assert.Contains(t, secondResult.ErrMsg, `task type "panics" is quarantined`)
+	})
+
+	t.Run("Counts panics recovered by middleware towards quarantine", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](2)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+
+		wp := New(1, WithPanicQuarantine(1, time.Hour), WithMiddleware(middleware.Recover()))
+
+		taskHandlers.Put("panics", func(_ context.Context, _ task.Task) task.Result {
+			panic("boom")
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "first", Type: "panics"})
+		_ = taskQueue.Submit(ctx, task.Task{ID: "second", Type: "panics"})
+
+		firstResult := finalResult(ctx, resultQueue)
+		secondResult := finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		// Assert
+		assert.Equal(t, "handler panicked: boom", firstResult.ErrMsg)
+		assert.Contains(t, secondResult.ErrMsg, `task type "panics" is quarantined`)
+	})
+
+	t.Run("Lifts the quarantine once the period has passed", func(t *testing.T) {
+		// Arrange
+		wp := New(1, WithPanicQuarantine(2, time.Millisecond))
+		tsk := task.Task{Type: "panics"}
+
+		// Act
+		wp.handlePanic(tsk, "boom", nil)
+		_, quarantinedAfterOne := wp.quarantinedUntil("panics")
+
+		wp.handlePanic(tsk, "boom", nil)
+		_, quarantinedAfterTwo := wp.quarantinedUntil("panics")
+
+		time.Sleep(2 * time.Millisecond)
+		_, quarantinedAfterPeriod := wp.quarantinedUntil("panics")
+
+		// Assert
+		assert.False(t, quarantinedAfterOne)
+		assert.True(t, quarantinedAfterTwo)
+		assert.False(t, quarantinedAfterPeriod)
+	})
+}
+
+func Test_Pool_DeadLetters(t *testing.T) {
+	t.Run("Dead-letters tasks with no handler and tasks that finally fail", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](2)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+		deadLetters := deadletter.NewInMemoryQueue()
+
+		wp := New(1, WithDeadLetterQueue(deadLetters))
+
+		taskHandlers.Put("fails", func(_ context.Context, _ task.Task) task.Result {
+			return task.Result{ErrMsg: "always fails"}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "unhandled", Type: "unknown"})
+		_ = taskQueue.Submit(ctx, task.Task{ID: "failed", Type: "fails"})
+
+		_ = finalResult(ctx, resultQueue)
+		_ = finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		entries, err := deadLetters.List(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, entries, 2)
+
+		assert.Equal(t, "unhandled", entries[0].Task.ID)
+		assert.Equal(t, `no handler registered for task type "unknown"`, entries[0].Reason)
+
+		assert.Equal(t, "failed", entries[1].Task.ID)
+		assert.Equal(t, "always fails", entries[1].Reason)
+		assert.Equal(t, 1, entries[1].Attempts)
+		assert.WithinDuration(t, time.Now(), entries[1].FailedAt, time.Second)
+	})
+
+	t.Run("Does not dead-letter a task that will be retried", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		taskQueue := broker.NewChannelBroker[task.Task](1)
+		resultQueue := broker.NewChannelBroker[task.Result](0)
+		taskHandlers := store.NewInMemoryKVStore[string, task.Handler]()
+		deadLetters := deadletter.NewInMemoryQueue()
+
+		taskType := "test_task"
+		wp := New(
+			1,
+			WithDeadLetterQueue(deadLetters),
+			WithRetryPolicies(map[string]retry.Policy{taskType: {MaxAttempts: 2}}),
+		)
+
+		taskHandlers.Put(taskType, func(_ context.Context, tsk task.Task) task.Result {
+			if tsk.Attempt < 2 {
+				return task.Result{ErrMsg: "transient"}
+			}
+
+			return task.Result{}
+		})
+
+		// Act
+		wp.Start(ctx, taskQueue, resultQueue, taskHandlers)
+		_ = taskQueue.Submit(ctx, task.Task{ID: "task-id", Type: taskType})
+
+		_ = finalResult(ctx, resultQueue)
+
+		cancel()
+		wp.AwaitShutdown()
+
+		entries, _ := deadLetters.List(context.Background())
+
+		// Assert
+		assert.Empty(t, entries)
+	})
+}
+
+type failingLimiter struct{}
+
+func (failingLimiter) Take(_ context.Context, _ string, _ ratelimit.Limit) (time.Duration, error) {
+	return 0, errors.New("limiter unavailable")
+}
+
+type countingLimiter struct {
+	ratelimit.Limiter
+
+	mu    sync.Mutex
+	count int
+}
+
+func (c *countingLimiter) Take(ctx context.Context, key string, limit ratelimit.Limit) (time.Duration, error) {
+	c.mu.Lock()
+	c.count++
+	c.mu.Unlock()
+
+	return c.Limiter.Take(ctx, key, limit)
+}
+
+func (c *countingLimiter) takes() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.count
+}
+
+// recordingQueue records the IDs of the tasks submitted to the queue.
+type recordingQueue struct {
+	TaskQueue
+
+	mu        sync.Mutex
+	submitted []string
+}
+
+func (r *recordingQueue) Submit(ctx context.Context, t task.Task) error {
+	r.mu.Lock()
+	r.submitted = append(r.submitted, t.ID)
+	r.mu.Unlock()
+
+	return r.TaskQueue.Submit(ctx, t)
+}
+
+func (r *recordingQueue) submittedIDs() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.submitted
+}
+
+type undelayedQueue struct {
+	task.Submitter[task.Task]
+	task.Dequeuer[task.Task]
+}
+
+// finalResult returns the next result that is not just a state update.
+func finalResult(ctx context.Context, results task.Dequeuer[task.Result]) task.Result {
+	for {
+		result := <-results.Dequeue(ctx)
+		if result.State.Terminal() {
+			return result
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidDefinition = errors.New("invalid workflow definition")
+
+// Definition declares a workflow as a directed acyclic graph of steps. Each step
+// pushes one task, and is only pushed once all of the steps it depends on have
+// succeeded.
+//
+// The payload of a step's task is chosen by its dependencies:
+//   - a step with no dependencies receives the workflow's input
+//   - a step with one dependency receives that step's result payload
+//   - a step with several dependencies receives a map[string]any from each
+//     dependency's name to its result payload
+type Definition struct {
+	Name  string
+	Steps []Step
+
+	// ContinueOnError keeps the workflow running when a step fails. Steps that
+	// depend on the failed step, directly or indirectly, are skipped, but the rest
+	// of the graph runs to completion. By default the workflow fails as soon as any
+	// step fails, and no further steps are pushed.
+	ContinueOnError bool
+}
+
+// Step is a node in a workflow.
+type Step struct {
+	// Name identifies the step within its workflow.
+	Name string
+
+	// TaskType is the type of the task pushed for the step.
+	TaskType string
+
+	// DependsOn names the steps whose results feed this one.
+	DependsOn []string
+}
+
+// Validate checks that the steps form a valid graph: names are unique, every
+// dependency exists and there are no cycles.
+func (d Definition) Validate() error {
+	_, err := d.order()
+
+	return err
+}
+
+// order returns the steps sorted so that every step comes after its dependencies,
+// keeping the declared order where the graph allows it.
+func (d Definition) order() ([]Step, error) {
+	if len(d.Steps) == 0 {
+		return nil, fmt.Errorf("%w: workflow has no steps", ErrInvalidDefinition)
+	}
+
+	byName := make(map[string]Step, len(d.Steps))
+
+	for _, step := range d.Steps {
+		if step.Name == "" {
+			return nil, fmt.Errorf("%w: step has no name", ErrInvalidDefinition)
+		}
+
+		if step.TaskType == "" {
+			return nil, fmt.Errorf("%w: step %q has no task type", ErrInvalidDefinition, step.Name)
+		}
+
+		if _, ok := byName[step.Name]; ok {
+			return nil, fmt.Errorf("%w: duplicate step %q", ErrInvalidDefinition, step.Name)
+		}
+
+		byName[step.Name] = step
+	}
+
+	for _, step := range d.Steps {
+		for _, dep := range step.DependsOn {
+			if _, ok := byName[dep]; !ok {
+				return nil, fmt.Errorf("%w: step %q depends on unknown step %q", ErrInvalidDefinition, step.Name, dep)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	marks := make(map[string]int, len(d.Steps))
+	ordered := make([]Step, 0, len(d.Steps))
+
+	var visit func(step Step) error
+
+	visit = func(step Step) error {
+		switch marks[step.Name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("%w: cycle through step %q", ErrInvalidDefinition, step.Name)
+		}
+
+		marks[step.Name] = visiting
+
+		for _, dep := range step.DependsOn {
+			if err := visit(byName[dep]); err != nil {
+				return err
+			}
+		}
+
+		marks[step.Name] = visited
+		ordered = append(ordered, step)
+
+		return nil
+	}
+
+	for _, step := range d.Steps {
+		if err := visit(step); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
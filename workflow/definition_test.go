@@ -0,0 +1,88 @@
+//go:build unit
+
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Definition_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		def  Definition
+		err  string
+	}{
+		{
+			name: "Rejects a workflow with no steps",
+			def:  Definition{Name: "empty"},
+			err:  "workflow has no steps",
+		},
+		{
+			name: "Rejects a step with no name",
+			def:  Definition{Steps: []Step{{TaskType: "resize"}}},
+			err:  "step has no name",
+		},
+		{
+			name: "Rejects a step with no task type",
+			def:  Definition{Steps: []Step{{Name: "resize"}}},
+			err:  `step "resize" has no task type`,
+		},
+		{
+			name: "Rejects duplicate step names",
+			def:  Definition{Steps: []Step{{Name: "a", TaskType: "t"}, {Name: "a", TaskType: "t"}}},
+			err:  `duplicate step "a"`,
+		},
+		{
+			name: "Rejects a dependency on an unknown step",
+			def:  Definition{Steps: []Step{{Name: "a", TaskType: "t", DependsOn: []string{"b"}}}},
+			err:  `step "a" depends on unknown step "b"`,
+		},
+		{
+			name: "Rejects a cycle",
+			def: Definition{Steps: []Step{
+				{Name: "a", TaskType: "t", DependsOn: []string{"c"}},
+				{Name: "b", TaskType: "t", DependsOn: []string{"a"}},
+				{Name: "c", TaskType: "t", DependsOn: []string{"b"}},
+			}},
+			err: "cycle through step",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := tt.def.Validate()
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidDefinition)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func Test_Definition_order(t *testing.T) {
+	t.Run("Orders every step after its dependencies", func(t *testing.T) {
+		// Arrange
+		def := Definition{Steps: []Step{
+			{Name: "index", TaskType: "t", DependsOn: []string{"thumbnail", "resize"}},
+			{Name: "thumbnail", TaskType: "t", DependsOn: []string{"resize"}},
+			{Name: "resize", TaskType: "t"},
+			{Name: "audit", TaskType: "t"},
+		}}
+
+		// Act
+		ordered, err := def.order()
+
+		// Assert
+		assert.NoError(t, err)
+
+		names := []string{}
+		for _, step := range ordered {
+			names = append(names, step.Name)
+		}
+
+		assert.Equal(t, []string{"resize", "thumbnail", "index", "audit"}, names)
+	})
+}
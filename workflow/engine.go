@@ -0,0 +1,337 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jamesTait-jt/goflow"
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+var ErrNotFound = errors.New("workflow run not found")
+
+// Pusher pushes the tasks that make up a workflow. *goflow.GoFlow implements it, in
+// both local and distributed mode.
+type Pusher interface {
+	Push(taskType string, payload any, opts ...goflow.PushOption) (string, error)
+}
+
+// Engine runs workflows on top of GoFlow. Each step is pushed as an ordinary task,
+// and the engine pushes a step's children when the results of its dependencies
+// arrive.
+//
+// A run is driven by the engine that started it, so it only makes progress while
+// that engine's GoFlow instance is running. Its status is written to the engine's
+// store after every change, so other engines sharing the store can report on it.
+type Engine struct {
+	pusher Pusher
+	runs   goflow.KVStore[string, Run]
+	opts   engineOptions
+
+	mu     sync.Mutex
+	active map[string]*activeRun
+}
+
+// activeRun is a run being driven by this engine.
+type activeRun struct {
+	mu              sync.Mutex
+	run             Run
+	steps           []Step
+	input           any
+	continueOnError bool
+	done            chan struct{}
+}
+
+// NewEngine creates an Engine that pushes the tasks for workflow steps to pusher.
+func NewEngine(pusher Pusher, opt ...Option) *Engine {
+	opts := defaultEngineOptions()
+
+	for _, o := range opt {
+		o.apply(&opts)
+	}
+
+	return &Engine{
+		pusher: pusher,
+		runs:   opts.store,
+		opts:   opts,
+		active: make(map[string]*activeRun),
+	}
+}
+
+// Start begins a run of the workflow, pushing the steps that have no dependencies
+// with input as their payload. It returns the ID of the run, which can be passed to
+// Get and Await.
+func (e *Engine) Start(def Definition, input any) (string, error) {
+	steps, err := def.order()
+	if err != nil {
+		return "", err
+	}
+
+	ar := &activeRun{
+		run: Run{
+			ID:        uuid.New().String(),
+			Workflow:  def.Name,
+			State:     StateRunning,
+			Steps:     make(map[string]StepStatus, len(steps)),
+			StartedAt: time.Now(),
+		},
+		steps:           steps,
+		input:           input,
+		continueOnError: def.ContinueOnError,
+		done:            make(chan struct{}),
+	}
+
+	for _, step := range steps {
+		ar.run.Steps[step.Name] = StepStatus{State: StepWaiting}
+	}
+
+	e.mu.Lock()
+	e.active[ar.run.ID] = ar
+	e.mu.Unlock()
+
+	ar.mu.Lock()
+	defer ar.mu.Unlock()
+
+	e.advance(ar)
+
+	return ar.run.ID, nil
+}
+
+// Get returns the current status of the run with the given ID, and whether it was
+// found.
+func (e *Engine) Get(runID string) (Run, bool) {
+	return e.runs.Get(runID)
+}
+
+// Await blocks until the run with the given ID has finished and returns its final
+// status. It returns ErrNotFound if there is no such run, and ctx.Err() if ctx is
+// done first.
+//
+// Runs driven by another engine are awaited by polling the store (see
+// WithPollInterval).
+func (e *Engine) Await(ctx context.Context, runID string) (Run, error) {
+	e.mu.Lock()
+	ar, ok := e.active[runID]
+	e.mu.Unlock()
+
+	if ok {
+		select {
+		case <-ctx.Done():
+			return Run{}, ctx.Err()
+		case <-ar.done:
+		}
+	}
+
+	ticker := time.NewTicker(e.opts.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		run, ok := e.runs.Get(runID)
+		if !ok {
+			return Run{}, ErrNotFound
+		}
+
+		if run.State.Terminal() {
+			return run, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return Run{}, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// advance pushes every step whose dependencies have succeeded, skips the steps
+// that can no longer run and finishes the run once nothing is left to wait for.
+// The caller must hold ar.mu.
+func (e *Engine) advance(ar *activeRun) {
+	for _, step := range ar.steps {
+		if ar.run.Steps[step.Name].State != StepWaiting || ar.failingFast() {
+			continue
+		}
+
+		switch ar.dependencyState(step) {
+		case StepSucceeded:
+			e.push(ar, step)
+
+		case StepFailed:
+			ar.setState(step.Name, StepSkipped)
+		}
+	}
+
+	if ar.failingFast() {
+		for _, step := range ar.steps {
+			if ar.run.Steps[step.Name].State == StepWaiting {
+				ar.setState(step.Name, StepSkipped)
+			}
+		}
+	}
+
+	if ar.run.State == StateRunning && ar.finished() {
+		e.finish(ar)
+
+		return
+	}
+
+	e.save(ar)
+}
+
+// push pushes the task for the step, recording the step as failed if that is not
+// possible. The caller must hold ar.mu.
+func (e *Engine) push(ar *activeRun, step Step) {
+	taskID, err := e.pusher.Push(
+		step.TaskType,
+		ar.payload(step),
+		goflow.WithCallback(func(result task.Result) {
+			e.complete(ar, step.Name, result)
+		}),
+	)
+	if err != nil {
+		ar.run.Steps[step.Name] = StepStatus{
+			State:  StepFailed,
+			Result: task.Result{ErrMsg: fmt.Sprintf("failed to push task: %v", err), State: task.StateFailed},
+		}
+
+		return
+	}
+
+	ar.run.Steps[step.Name] = StepStatus{State: StepRunning, TaskID: taskID}
+}
+
+// complete records the result of a step and moves the run on. Steps that were
+// already running when the run failed fast are still recorded.
+func (e *Engine) complete(ar *activeRun, stepName string, result task.Result) {
+	ar.mu.Lock()
+	defer ar.mu.Unlock()
+
+	status := ar.run.Steps[stepName]
+	status.Result = result
+	status.State = StepSucceeded
+
+	if result.Status().State != task.StateSucceeded {
+		status.State = StepFailed
+	}
+
+	ar.run.Steps[stepName] = status
+
+	if ar.run.State != StateRunning {
+		e.save(ar)
+
+		return
+	}
+
+	e.advance(ar)
+}
+
+// finish records the final state of the run and releases anything awaiting it. The
+// caller must hold ar.mu.
+func (e *Engine) finish(ar *activeRun) {
+	ar.run.State = StateSucceeded
+
+	for _, status := range ar.run.Steps {
+		if status.State != StepSucceeded {
+			ar.run.State = StateFailed
+
+			break
+		}
+	}
+
+	ar.run.FinishedAt = time.Now()
+
+	e.save(ar)
+
+	e.mu.Lock()
+	delete(e.active, ar.run.ID)
+	e.mu.Unlock()
+
+	close(ar.done)
+}
+
+// save writes a snapshot of the run to the store. The caller must hold ar.mu.
+func (e *Engine) save(ar *activeRun) {
+	e.runs.Put(ar.run.ID, ar.run.clone())
+}
+
+// payload returns the payload for the step's task: the run's input for steps with
+// no dependencies, otherwise the results of its dependencies.
+func (ar *activeRun) payload(step Step) any {
+	switch len(step.DependsOn) {
+	case 0:
+		return ar.input
+
+	case 1:
+		return ar.run.Steps[step.DependsOn[0]].Result.Payload
+
+	default:
+		payloads := make(map[string]any, len(step.DependsOn))
+
+		for _, dep := range step.DependsOn {
+			payloads[dep] = ar.run.Steps[dep].Result.Payload
+		}
+
+		return payloads
+	}
+}
+
+// dependencyState summarises the step's dependencies: StepSucceeded if they all
+// succeeded, StepFailed if any failed or was skipped, and StepWaiting otherwise.
+func (ar *activeRun) dependencyState(step Step) StepState {
+	state := StepSucceeded
+
+	for _, dep := range step.DependsOn {
+		switch ar.run.Steps[dep].State {
+		case StepSucceeded:
+		case StepFailed, StepSkipped:
+			return StepFailed
+		default:
+			state = StepWaiting
+		}
+	}
+
+	return state
+}
+
+// failingFast reports whether a step has failed in a run that stops on the first
+// failure.
+func (ar *activeRun) failingFast() bool {
+	if ar.continueOnError {
+		return false
+	}
+
+	for _, status := range ar.run.Steps {
+		if status.State == StepFailed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// finished reports whether there is nothing left to wait for. Runs that are failing
+// fast do not wait for the steps that are still running.
+func (ar *activeRun) finished() bool {
+	for _, status := range ar.run.Steps {
+		switch status.State {
+		case StepWaiting:
+			return false
+		case StepRunning:
+			if !ar.failingFast() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (ar *activeRun) setState(stepName string, state StepState) {
+	status := ar.run.Steps[stepName]
+	status.State = state
+	ar.run.Steps[stepName] = status
+}
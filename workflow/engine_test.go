@@ -0,0 +1,259 @@
+//go:build unit
+
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jamesTait-jt/goflow"
+	"github.com/jamesTait-jt/goflow/pkg/store"
+	"github.com/jamesTait-jt/goflow/task"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Engine_Run(t *testing.T) {
+	t.Run("Feeds each step's result into the next", func(t *testing.T) {
+		// Arrange
+		gf := newLocalGoFlow(t, map[string]task.Handler{
+			"resize":    appendHandler("resized"),
+			"thumbnail": appendHandler("thumbnailed"),
+			"index":     appendHandler("indexed"),
+		})
+		engine := NewEngine(gf)
+
+		def := Definition{Name: "images", Steps: []Step{
+			{Name: "resize", TaskType: "resize"},
+			{Name: "thumbnail", TaskType: "thumbnail", DependsOn: []string{"resize"}},
+			{Name: "index", TaskType: "index", DependsOn: []string{"thumbnail"}},
+		}}
+
+		// Act
+		runID, err := engine.Start(def, "img")
+		run, awaitErr := awaitRun(engine, runID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, awaitErr)
+		assert.Equal(t, StateSucceeded, run.State)
+		assert.Equal(t, "images", run.Workflow)
+		assert.Equal(t, "img-resized-thumbnailed-indexed", run.Steps["index"].Result.Payload)
+		assert.NotEmpty(t, run.Steps["index"].TaskID)
+		assert.False(t, run.FinishedAt.IsZero())
+	})
+
+	t.Run("Passes a step with several dependencies all of their results", func(t *testing.T) {
+		// Arrange
+		var received any
+
+		gf := newLocalGoFlow(t, map[string]task.Handler{
+			"resize":    appendHandler("resized"),
+			"thumbnail": appendHandler("thumbnailed"),
+			"index": func(_ context.Context, tsk task.Task) task.Result {
+				received = tsk.Payload
+				return task.Result{Payload: "indexed"}
+			},
+		})
+		engine := NewEngine(gf)
+
+		def := Definition{Steps: []Step{
+			{Name: "resize", TaskType: "resize"},
+			{Name: "thumbnail", TaskType: "thumbnail", DependsOn: []string{"resize"}},
+			{Name: "index", TaskType: "index", DependsOn: []string{"resize", "thumbnail"}},
+		}}
+
+		// Act
+		runID, _ := engine.Start(def, "img")
+		run, err := awaitRun(engine, runID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, StateSucceeded, run.State)
+		assert.Equal(t, map[string]any{
+			"resize":    "img-resized",
+			"thumbnail": "img-resized-thumbnailed",
+		}, received)
+	})
+
+	t.Run("Fails fast when a step fails", func(t *testing.T) {
+		// Arrange
+		gf := newLocalGoFlow(t, map[string]task.Handler{
+			"resize":    failHandler,
+			"thumbnail": appendHandler("thumbnailed"),
+		})
+		engine := NewEngine(gf)
+
+		def := Definition{Steps: []Step{
+			{Name: "resize", TaskType: "resize"},
+			{Name: "thumbnail", TaskType: "thumbnail", DependsOn: []string{"resize"}},
+		}}
+
+		// Act
+		runID, _ := engine.Start(def, "img")
+		run, err := awaitRun(engine, runID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, StateFailed, run.State)
+		assert.Equal(t, StepFailed, run.Steps["resize"].State)
+		assert.Equal(t, "boom", run.Steps["resize"].Result.ErrMsg)
+		assert.Equal(t, StepSkipped, run.Steps["thumbnail"].State)
+	})
+
+	t.Run("Runs the independent steps when continuing on error", func(t *testing.T) {
+		// Arrange
+		gf := newLocalGoFlow(t, map[string]task.Handler{
+			"resize":    failHandler,
+			"thumbnail": appendHandler("thumbnailed"),
+			"audit":     appendHandler("audited"),
+		})
+		engine := NewEngine(gf)
+
+		def := Definition{
+			ContinueOnError: true,
+			Steps: []Step{
+				{Name: "resize", TaskType: "resize"},
+				{Name: "thumbnail", TaskType: "thumbnail", DependsOn: []string{"resize"}},
+				{Name: "index", TaskType: "thumbnail", DependsOn: []string{"thumbnail"}},
+				{Name: "audit", TaskType: "audit"},
+			},
+		}
+
+		// Act
+		runID, _ := engine.Start(def, "img")
+		run, err := awaitRun(engine, runID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, StateFailed, run.State)
+		assert.Equal(t, StepFailed, run.Steps["resize"].State)
+		assert.Equal(t, StepSkipped, run.Steps["thumbnail"].State)
+		assert.Equal(t, StepSkipped, run.Steps["index"].State)
+		assert.Equal(t, StepSucceeded, run.Steps["audit"].State)
+		assert.Equal(t, "img-audited", run.Steps["audit"].Result.Payload)
+
+		done, total := run.Progress()
+		assert.Equal(t, 4, done)
+		assert.Equal(t, 4, total)
+	})
+
+	t.Run("Fails the step if its task cannot be pushed", func(t *testing.T) {
+		// Arrange
+		engine := NewEngine(failingPusher{})
+
+		def := Definition{Steps: []Step{{Name: "resize", TaskType: "resize"}}}
+
+		// Act
+		runID, err := engine.Start(def, "img")
+		run, found := engine.Get(runID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, StateFailed, run.State)
+		assert.Equal(t, "failed to push task: broker down", run.Steps["resize"].Result.ErrMsg)
+	})
+
+	t.Run("Returns an error for an invalid definition", func(t *testing.T) {
+		// Arrange
+		engine := NewEngine(failingPusher{})
+
+		// Act
+		_, err := engine.Start(Definition{}, "img")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidDefinition)
+	})
+}
+
+func Test_Engine_Await(t *testing.T) {
+	t.Run("Polls the store for runs driven by another engine", func(t *testing.T) {
+		// Arrange
+		runs := store.NewInMemoryKVStore[string, Run]()
+		runs.Put("run-id", Run{ID: "run-id", State: StateRunning})
+
+		engine := NewEngine(failingPusher{}, WithStore(runs), WithPollInterval(time.Millisecond))
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			runs.Put("run-id", Run{ID: "run-id", State: StateSucceeded})
+		}()
+
+		// Act
+		run, err := awaitRun(engine, "run-id")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, StateSucceeded, run.State)
+	})
+
+	t.Run("Returns ErrNotFound for an unknown run", func(t *testing.T) {
+		// Arrange
+		engine := NewEngine(failingPusher{})
+
+		// Act
+		_, err := awaitRun(engine, "run-id")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Returns the context error if the context is done first", func(t *testing.T) {
+		// Arrange
+		runs := store.NewInMemoryKVStore[string, Run]()
+		runs.Put("run-id", Run{ID: "run-id", State: StateRunning})
+
+		engine := NewEngine(failingPusher{}, WithStore(runs))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		// Act
+		_, err := engine.Await(ctx, "run-id")
+
+		// Assert
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func newLocalGoFlow(t *testing.T, handlers map[string]task.Handler) *goflow.GoFlow {
+	t.Helper()
+
+	handlerStore := store.NewInMemoryKVStore[string, task.Handler]()
+	for taskType, handler := range handlers {
+		handlerStore.Put(taskType, handler)
+	}
+
+	gf := goflow.NewLocalMode(handlerStore)
+	_ = gf.Start()
+
+	t.Cleanup(func() { _ = gf.Close() })
+
+	return gf
+}
+
+func awaitRun(engine *Engine, runID string) (Run, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return engine.Await(ctx, runID)
+}
+
+func appendHandler(suffix string) task.Handler {
+	return func(_ context.Context, t task.Task) task.Result {
+		return task.Result{Payload: fmt.Sprintf("%v-%s", t.Payload, suffix)}
+	}
+}
+
+func failHandler(_ context.Context, _ task.Task) task.Result {
+	return task.Result{ErrMsg: "boom"}
+}
+
+type failingPusher struct{}
+
+func (failingPusher) Push(string, any, ...goflow.PushOption) (string, error) {
+	return "", errors.New("broker down")
+}
@@ -0,0 +1,56 @@
+package workflow
+
+import (
+	"time"
+
+	"github.com/jamesTait-jt/goflow"
+	"github.com/jamesTait-jt/goflow/pkg/store"
+)
+
+var defaultPollInterval = time.Second
+
+type engineOptions struct {
+	store        goflow.KVStore[string, Run]
+	pollInterval time.Duration
+}
+
+func defaultEngineOptions() engineOptions {
+	return engineOptions{
+		store:        store.NewInMemoryKVStore[string, Run](),
+		pollInterval: defaultPollInterval,
+	}
+}
+
+// An Option configures an Engine.
+type Option interface {
+	apply(*engineOptions)
+}
+
+type storeOption struct {
+	Store goflow.KVStore[string, Run]
+}
+
+func (s storeOption) apply(opts *engineOptions) {
+	opts.store = s.Store
+}
+
+// WithStore allows you to inject your own store for the status of workflow runs.
+// Defaults to an in-memory store. Engines sharing a store can report on, and await,
+// each other's runs.
+func WithStore(runs goflow.KVStore[string, Run]) Option {
+	return storeOption{Store: runs}
+}
+
+type pollIntervalOption struct {
+	PollInterval time.Duration
+}
+
+func (p pollIntervalOption) apply(opts *engineOptions) {
+	opts.pollInterval = p.PollInterval
+}
+
+// WithPollInterval allows you to set how often Await checks the store for runs
+// driven by another engine. Defaults to one second.
+func WithPollInterval(interval time.Duration) Option {
+	return pollIntervalOption{PollInterval: interval}
+}
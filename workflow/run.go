@@ -0,0 +1,90 @@
+package workflow
+
+import (
+	"maps"
+	"time"
+
+	"github.com/jamesTait-jt/goflow/task"
+)
+
+// State is the overall state of a workflow run.
+type State string
+
+const (
+	// StateRunning runs still have steps waiting or running.
+	StateRunning State = "running"
+
+	// StateSucceeded runs have finished with every step succeeding.
+	StateSucceeded State = "succeeded"
+
+	// StateFailed runs have finished with at least one failed step.
+	StateFailed State = "failed"
+)
+
+// Terminal reports whether a run in this state has finished.
+func (s State) Terminal() bool {
+	return s == StateSucceeded || s == StateFailed
+}
+
+// StepState is the state of a single step in a workflow run.
+type StepState string
+
+const (
+	// StepWaiting steps are waiting for their dependencies to succeed.
+	StepWaiting StepState = "waiting"
+
+	// StepRunning steps have had their task pushed.
+	StepRunning StepState = "running"
+
+	// StepSucceeded steps have a successful result.
+	StepSucceeded StepState = "succeeded"
+
+	// StepFailed steps have a failed result, or their task could not be pushed.
+	StepFailed StepState = "failed"
+
+	// StepSkipped steps will never run, because a dependency failed or the
+	// workflow failed fast.
+	StepSkipped StepState = "skipped"
+)
+
+// StepStatus is the progress of a single step in a workflow run.
+type StepStatus struct {
+	State StepState
+
+	// TaskID is the ID of the step's task, once it has been pushed.
+	TaskID string
+
+	// Result is the step's result, once it has finished.
+	Result task.Result
+}
+
+// Run is the aggregate status of one execution of a workflow.
+type Run struct {
+	ID       string
+	Workflow string
+	State    State
+	Steps    map[string]StepStatus
+
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// Progress returns how many of the run's steps have finished, including skipped
+// steps, out of the total number of steps.
+func (r Run) Progress() (done, total int) {
+	for _, step := range r.Steps {
+		if step.State != StepWaiting && step.State != StepRunning {
+			done++
+		}
+	}
+
+	return done, len(r.Steps)
+}
+
+// clone returns a copy of the run that does not share its steps map, so that it
+// can be handed out while the original keeps changing.
+func (r Run) clone() Run {
+	r.Steps = maps.Clone(r.Steps)
+
+	return r
+}